@@ -2,10 +2,11 @@ package axiom
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/axiomhq/axiom-go/axiom"
@@ -19,25 +20,27 @@ func NewDatasetDataSource() datasource.DataSource {
 }
 
 type DatasetDataSource struct {
-	client *axiom.Client
+	client        *axiom.Client
+	organizations *organizationCache
+	edge          edgeConfig
 }
 
-func (d *DatasetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
+// datasetDataSourceModel adds the ingest URL, which only the data source
+// exposes, to the dataset model shared with the resource.
+type datasetDataSourceModel struct {
+	DatasetResourceModel
+	IngestURL types.String `tfsdk:"ingest_url"`
+}
 
-	client, ok := req.ProviderData.(*axiom.Client)
+func (d *DatasetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Data Source", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected datasource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
 
-	d.client = client
+	d.client = data.client
+	d.organizations = data.organizations
+	d.edge = data.edge
 }
 
 func (d *DatasetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,11 +52,19 @@ func (d *DatasetDataSource) Schema(ctx context.Context, _ datasource.SchemaReque
 	var resourceResp resource.SchemaResponse
 	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
 
+	for _, attribute := range datasetManagedAttributes {
+		delete(resourceResp.Schema.Attributes, attribute)
+	}
+
 	resp.Schema = frameworkDatasourceSchemaFromFrameworkResourceSchema(resourceResp.Schema)
+	resp.Schema.Attributes["ingest_url"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "URL to ingest events into the dataset with, for example from `axiom_dataset_ingest` or a log shipper. Uses the edge endpoint configured with `AXIOM_EDGE_URL` or `AXIOM_EDGE` when set. Null for datasets on an edge deployment when no edge endpoint is configured",
+	}
 }
 
 func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan DatasetResourceModel
+	var plan datasetDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -71,11 +82,21 @@ func (d *DatasetDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	state, err := flattenDatasetWithOrgDefault(ctx, d.client, ds)
+	state, err := flattenDatasetWithOrgDefault(ctx, d.organizations, ds)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
 		state = flattenDataset(ds, "")
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	ingestURL, err := datasetIngestURL(ctx, d.client, d.edge, ds)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to build dataset ingest url", err.Error())
+		tflog.Error(ctx, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, datasetDataSourceModel{
+		DatasetResourceModel: state,
+		IngestURL:            stringOrNull(ingestURL),
+	})...)
 }
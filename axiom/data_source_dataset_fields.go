@@ -0,0 +1,187 @@
+package axiom
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// mapFieldType is the type reported for map fields, which have no entry of
+// their own in the fields of a dataset.
+const mapFieldType = "map"
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &DatasetFieldsDataSource{}
+
+func NewDatasetFieldsDataSource() datasource.DataSource {
+	return &DatasetFieldsDataSource{}
+}
+
+type DatasetFieldsDataSource struct {
+	client *axiom.Client
+}
+
+// DatasetFieldsDataSourceModel describes the data source data model.
+type DatasetFieldsDataSourceModel struct {
+	Dataset types.String        `tfsdk:"dataset"`
+	Fields  []DatasetFieldModel `tfsdk:"fields"`
+}
+
+// DatasetFieldModel describes a single field of a dataset.
+type DatasetFieldModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Unit        types.String `tfsdk:"unit"`
+	Description types.String `tfsdk:"description"`
+	IsMapField  types.Bool   `tfsdk:"is_map_field"`
+}
+
+func (d *DatasetFieldsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Data Source", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *DatasetFieldsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_fields"
+}
+
+func (d *DatasetFieldsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the fields of a dataset, including its map fields.",
+		Attributes: map[string]schema.Attribute{
+			"dataset": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the dataset",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"fields": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fields of the dataset, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the field",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the field (for example, 'string' or 'integer'). Map fields have the type 'map'",
+						},
+						"unit": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unit of the field",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the field",
+						},
+						"is_map_field": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the field is a map field",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DatasetFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DatasetFieldsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("axiom client is nil", "looks like the client wasn't setup properly")
+		return
+	}
+
+	dataset := config.Dataset.ValueString()
+
+	fields, err := listDatasetFields(ctx, d.client, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list dataset fields", err.Error())
+		tflog.Error(ctx, err.Error())
+		return
+	}
+
+	mapFields, err := d.client.Datasets.ListMapFields(ctx, dataset)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list dataset map fields", err.Error())
+		tflog.Error(ctx, err.Error())
+		return
+	}
+
+	config.Fields = flattenDatasetFields(fields, mapFields)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// flattenDatasetFields merges the fields and map fields of a dataset, sorted
+// by name. Map fields don't have an entry of their own in the fields, so they
+// are added with the map field type. Every field the API reports is returned,
+// including map fields that the dataset resource would not accept as input.
+func flattenDatasetFields(fields []datasetField, mapFields axiom.MapFields) []DatasetFieldModel {
+	isMapField := make(map[string]bool, len(mapFields))
+	for _, name := range mapFields {
+		isMapField[name] = true
+	}
+
+	result := make([]DatasetFieldModel, 0, len(fields)+len(mapFields))
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		seen[field.Name] = true
+		result = append(result, DatasetFieldModel{
+			Name:        types.StringValue(field.Name),
+			Type:        stringOrNull(field.Type),
+			Unit:        stringOrNull(field.Unit),
+			Description: stringOrNull(field.Description),
+			IsMapField:  types.BoolValue(isMapField[field.Name]),
+		})
+	}
+
+	for _, name := range mapFields {
+		if seen[name] {
+			continue
+		}
+		result = append(result, DatasetFieldModel{
+			Name:        types.StringValue(name),
+			Type:        types.StringValue(mapFieldType),
+			Unit:        types.StringNull(),
+			Description: types.StringNull(),
+			IsMapField:  types.BoolValue(true),
+		})
+	}
+
+	slices.SortFunc(result, func(a, b DatasetFieldModel) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	return result
+}
+
+// stringOrNull returns a null string for empty values, the same way empty
+// optional values are flattened elsewhere.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
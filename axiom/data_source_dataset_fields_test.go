@@ -0,0 +1,93 @@
+package axiom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ax "github.com/axiomhq/axiom-go/axiom"
+)
+
+func TestDatasetFieldsDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	var resp datasource.SchemaResponse
+	(&DatasetFieldsDataSource{}).Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError())
+}
+
+func TestFlattenDatasetFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []datasetField{
+		{Name: "status", Type: "integer", Description: "HTTP status code"},
+		{Name: "duration", Type: "float", Unit: "ms"},
+		{Name: "attributes", Type: "map[string]"},
+	}
+
+	flattened := flattenDatasetFields(fields, ax.MapFields{"attributes", "resource.labels", "-invalid"})
+
+	assert.Equal(t, []DatasetFieldModel{
+		{
+			Name:        types.StringValue("-invalid"),
+			Type:        types.StringValue(mapFieldType),
+			Unit:        types.StringNull(),
+			Description: types.StringNull(),
+			IsMapField:  types.BoolValue(true),
+		},
+		{
+			Name:        types.StringValue("attributes"),
+			Type:        types.StringValue("map[string]"),
+			Unit:        types.StringNull(),
+			Description: types.StringNull(),
+			IsMapField:  types.BoolValue(true),
+		},
+		{
+			Name:        types.StringValue("duration"),
+			Type:        types.StringValue("float"),
+			Unit:        types.StringValue("ms"),
+			Description: types.StringNull(),
+			IsMapField:  types.BoolValue(false),
+		},
+		{
+			Name:        types.StringValue("resource.labels"),
+			Type:        types.StringValue(mapFieldType),
+			Unit:        types.StringNull(),
+			Description: types.StringNull(),
+			IsMapField:  types.BoolValue(true),
+		},
+		{
+			Name:        types.StringValue("status"),
+			Type:        types.StringValue("integer"),
+			Unit:        types.StringNull(),
+			Description: types.StringValue("HTTP status code"),
+			IsMapField:  types.BoolValue(false),
+		},
+	}, flattened)
+}
+
+func TestListDatasetFields(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v2/datasets/logs/fields", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name":"status","type":"integer","unit":"","description":"HTTP status code","hidden":false}]`))
+	}))
+	t.Cleanup(srv.Close)
+
+	fields, err := listDatasetFields(context.Background(), newTestClient(t, srv.URL), "logs")
+	require.NoError(t, err)
+
+	assert.Equal(t, []datasetField{{Name: "status", Type: "integer", Description: "HTTP status code"}}, fields)
+}
@@ -0,0 +1,217 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &DatasetStatsDataSource{}
+
+func NewDatasetStatsDataSource() datasource.DataSource {
+	return &DatasetStatsDataSource{}
+}
+
+type DatasetStatsDataSource struct {
+	client *axiom.Client
+}
+
+// DatasetStatsDataSourceModel describes the data source data model.
+type DatasetStatsDataSourceModel struct {
+	Dataset               types.String        `tfsdk:"dataset"`
+	IncludeLastIngestTime types.Bool          `tfsdk:"include_last_ingest_time"`
+	Datasets              []DatasetStatsModel `tfsdk:"datasets"`
+}
+
+// DatasetStatsModel describes the usage of a single dataset.
+type DatasetStatsModel struct {
+	Name              types.String `tfsdk:"name"`
+	Events            types.Int64  `tfsdk:"events"`
+	CompressedBytes   types.Int64  `tfsdk:"compressed_bytes"`
+	UncompressedBytes types.Int64  `tfsdk:"uncompressed_bytes"`
+	MinTime           types.String `tfsdk:"min_time"`
+	MaxTime           types.String `tfsdk:"max_time"`
+	LastIngestTime    types.String `tfsdk:"last_ingest_time"`
+}
+
+func (d *DatasetStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Data Source", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = data.client
+}
+
+func (d *DatasetStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_stats"
+}
+
+func (d *DatasetStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the usage of the datasets of the organization, for example to check that a dataset still receives data.",
+		Attributes: map[string]schema.Attribute{
+			"dataset": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only report the usage of the dataset with this name",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"include_last_ingest_time": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to query `last_ingest_time`, which runs a query for each reported dataset on every read. Defaults to `false`",
+			},
+			"datasets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The usage of the datasets, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the dataset",
+						},
+						"events": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of events stored in the dataset",
+						},
+						"compressed_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The size of the stored events in bytes, after compression",
+						},
+						"uncompressed_bytes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The size of the ingested events in bytes, before compression",
+						},
+						"min_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the oldest event in RFC3339 format. Null if the dataset is empty",
+						},
+						"max_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the newest event in RFC3339 format. Events can carry any timestamp, so this is not necessarily when the dataset last received data. Null if the dataset is empty",
+						},
+						"last_ingest_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the dataset last received data in RFC3339 format. The usage endpoint doesn't report this, so it is the latest ingest time (`_sysTime`) of the events up to 24 hours older than `max_time`, queried for each reported dataset; use `dataset` to limit the queries to one dataset. Null unless `include_last_ingest_time` is `true`, and if the dataset is empty or the query fails",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DatasetStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DatasetStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("axiom client is nil", "looks like the client wasn't setup properly")
+		return
+	}
+
+	stats, err := listDatasetStats(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list dataset stats", err.Error())
+		tflog.Error(ctx, err.Error())
+		return
+	}
+
+	config.Datasets = flattenDatasetStats(stats, config.Dataset.ValueString())
+	if !config.Dataset.IsNull() && len(config.Datasets) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dataset"),
+			"Dataset Not Found",
+			fmt.Sprintf("No usage is reported for dataset %s. Check that the dataset exists.", config.Dataset.ValueString()),
+		)
+		return
+	}
+
+	if config.IncludeLastIngestTime.ValueBool() {
+		resp.Diagnostics.Append(setLastIngestTimes(ctx, d.client, stats, config.Datasets)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// setLastIngestTimes queries the last ingest time of every non-empty dataset.
+// Failed queries only produce a warning and leave the time null.
+func setLastIngestTimes(ctx context.Context, client *axiom.Client, stats []datasetStats, datasets []DatasetStatsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statsByName := make(map[string]datasetStats, len(stats))
+	for _, s := range stats {
+		statsByName[s.Name] = s
+	}
+	for i, dataset := range datasets {
+		s := statsByName[dataset.Name.ValueString()]
+		if s.NumEvents == 0 || s.MinTime.IsZero() || s.MaxTime.IsZero() {
+			continue
+		}
+
+		lastIngest, err := queryLastIngestTime(ctx, client, s)
+		if err != nil {
+			diags.AddWarning(
+				"Unable To Determine Last Ingest Time",
+				fmt.Sprintf("The last ingest time of dataset %s could not be queried: %s", s.Name, err),
+			)
+			continue
+		}
+		datasets[i].LastIngestTime = timeOrNull(lastIngest)
+	}
+
+	return diags
+}
+
+// flattenDatasetStats returns the usage of the datasets sorted by name, only
+// keeping the dataset with the given name if it isn't empty.
+func flattenDatasetStats(stats []datasetStats, dataset string) []DatasetStatsModel {
+	result := make([]DatasetStatsModel, 0, len(stats))
+	for _, s := range stats {
+		if dataset != "" && s.Name != dataset {
+			continue
+		}
+		result = append(result, DatasetStatsModel{
+			Name:              types.StringValue(s.Name),
+			Events:            types.Int64Value(int64(s.NumEvents)),
+			CompressedBytes:   types.Int64Value(int64(s.CompressedBytes)),
+			UncompressedBytes: types.Int64Value(int64(s.InputBytes)),
+			MinTime:           timeOrNull(s.MinTime),
+			MaxTime:           timeOrNull(s.MaxTime),
+			LastIngestTime:    types.StringNull(),
+		})
+	}
+
+	slices.SortFunc(result, func(a, b DatasetStatsModel) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	return result
+}
+
+// timeOrNull returns a null string for zero times and the time in RFC3339
+// format otherwise.
+func timeOrNull(value time.Time) types.String {
+	if value.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(value.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,106 @@
+package axiom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasetStatsDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	var resp datasource.SchemaResponse
+	(&DatasetStatsDataSource{}).Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError())
+}
+
+func TestListDatasetStats(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/datasets/_stats", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"datasets":[
+			{"name":"web-logs","numEvents":1200,"inputBytes":4096,"compressedBytes":512,"minTime":"2026-10-01T00:00:00Z","maxTime":"2026-10-15T12:30:00+02:00"},
+			{"name":"empty","numEvents":0,"inputBytes":0,"compressedBytes":0,"minTime":"0001-01-01T00:00:00Z","maxTime":"0001-01-01T00:00:00Z"},
+			{"name":"api-logs","numEvents":3,"inputBytes":30,"compressedBytes":10,"minTime":"2026-10-16T08:00:00Z","maxTime":"2026-10-16T09:00:00Z"}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	stats, err := listDatasetStats(context.Background(), newTestClient(t, srv.URL))
+	require.NoError(t, err)
+
+	all := flattenDatasetStats(stats, "")
+	require.Len(t, all, 3)
+	assert.Equal(t, "api-logs", all[0].Name.ValueString())
+	assert.Equal(t, "empty", all[1].Name.ValueString())
+	assert.True(t, all[1].MinTime.IsNull())
+	assert.True(t, all[1].MaxTime.IsNull())
+	assert.True(t, all[1].LastIngestTime.IsNull())
+
+	filtered := flattenDatasetStats(stats, "web-logs")
+	require.Len(t, filtered, 1)
+	assert.EqualValues(t, 1200, filtered[0].Events.ValueInt64())
+	assert.EqualValues(t, 512, filtered[0].CompressedBytes.ValueInt64())
+	assert.EqualValues(t, 4096, filtered[0].UncompressedBytes.ValueInt64())
+	assert.Equal(t, "2026-10-01T00:00:00Z", filtered[0].MinTime.ValueString())
+	assert.Equal(t, "2026-10-15T10:30:00Z", filtered[0].MaxTime.ValueString())
+
+	assert.Empty(t, flattenDatasetStats(stats, "missing"))
+}
+
+func TestQueryLastIngestTime(t *testing.T) {
+	t.Parallel()
+
+	wantStart := "2026-10-16T08:00:00Z"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/datasets/_apl", r.URL.Path)
+
+		var body struct {
+			APL       string `json:"apl"`
+			StartTime string `json:"startTime"`
+			EndTime   string `json:"endTime"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "['api-logs'] | summarize last_ingest_time = max(_sysTime)", body.APL)
+		assert.Equal(t, wantStart, body.StartTime)
+		assert.Equal(t, "2026-10-16T09:00:01Z", body.EndTime)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tables":[{"name":"0","fields":[{"name":"last_ingest_time","type":"datetime"}],"columns":[["2026-10-16T09:05:00.5Z"]]}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	lastIngest, err := queryLastIngestTime(context.Background(), newTestClient(t, srv.URL), datasetStats{
+		Name:      "api-logs",
+		NumEvents: 3,
+		MinTime:   time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC),
+		MaxTime:   time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2026-10-16T09:05:00Z", timeOrNull(lastIngest).ValueString())
+
+	// Older events are left out of the query.
+	wantStart = "2026-10-15T09:00:00Z"
+	_, err = queryLastIngestTime(context.Background(), newTestClient(t, srv.URL), datasetStats{
+		Name:      "api-logs",
+		NumEvents: 3,
+		MinTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaxTime:   time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+}
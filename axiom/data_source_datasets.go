@@ -0,0 +1,196 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &DatasetsDataSource{}
+
+func NewDatasetsDataSource() datasource.DataSource {
+	return &DatasetsDataSource{}
+}
+
+type DatasetsDataSource struct {
+	client        *axiom.Client
+	organizations *organizationCache
+}
+
+// DatasetsDataSourceModel describes the data source data model.
+type DatasetsDataSourceModel struct {
+	NameRegex      types.String           `tfsdk:"name_regex"`
+	Kind           types.String           `tfsdk:"kind"`
+	EdgeDeployment types.String           `tfsdk:"edge_deployment"`
+	Names          types.List             `tfsdk:"names"`
+	Datasets       []DatasetResourceModel `tfsdk:"datasets"`
+}
+
+func (d *DatasetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Data Source", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = data.client
+	d.organizations = data.organizations
+}
+
+func (d *DatasetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datasets"
+}
+
+func (d *DatasetsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Every dataset is described the same way as by the dataset data source.
+	var r DatasetResource
+	var resourceResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
+
+	for _, attribute := range datasetManagedAttributes {
+		delete(resourceResp.Schema.Attributes, attribute)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the datasets of the organization, optionally filtered by name, kind or edge deployment.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return datasets whose name matches this regular expression",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return datasets of this kind. Must be one of: 'axiom:events:v1', 'otel:metrics:v1', 'otel:traces:v1', 'otel:logs:v1'",
+				Validators: []validator.String{
+					stringvalidator.OneOf(datasetKinds...),
+				},
+			},
+			"edge_deployment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return datasets in this edge deployment (for example, 'cloud.eu-central-1.aws')",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the matching datasets, sorted alphabetically",
+			},
+			"datasets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching datasets, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: convertAttributes(resourceResp.Schema.Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (d *DatasetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DatasetsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("axiom client is nil", "looks like the client wasn't setup properly")
+		return
+	}
+
+	var nameRe *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		nameRe, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("Unable to compile %q: %s", config.NameRegex.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	datasets, err := d.client.Datasets.List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list datasets", err.Error())
+		tflog.Error(ctx, err.Error())
+		return
+	}
+
+	flattened := make([]DatasetResourceModel, 0, len(datasets))
+	warned := false
+	for _, ds := range datasets {
+		state, err := flattenDatasetWithOrgDefault(ctx, d.organizations, ds)
+		if err != nil {
+			// The organizations are cached, so the lookup fails for every
+			// dataset without an edge deployment alike. Only warn once.
+			if !warned {
+				resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
+				warned = true
+			}
+			state = flattenDataset(ds, "")
+		}
+		flattened = append(flattened, state)
+	}
+
+	config.Datasets = filterDatasets(flattened, nameRe, config.Kind.ValueString(), config.EdgeDeployment.ValueString())
+
+	names := make([]string, 0, len(config.Datasets))
+	for _, ds := range config.Datasets {
+		names = append(names, ds.Name.ValueString())
+	}
+
+	namesValue, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Names = namesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, config)...)
+}
+
+// filterDatasets returns the datasets that match all of the given filters,
+// sorted by name. A nil regular expression or an empty kind or edge
+// deployment matches every dataset.
+func filterDatasets(datasets []DatasetResourceModel, nameRe *regexp.Regexp, kind, edgeDeployment string) []DatasetResourceModel {
+	filtered := make([]DatasetResourceModel, 0, len(datasets))
+	for _, ds := range datasets {
+		if nameRe != nil && !nameRe.MatchString(ds.Name.ValueString()) {
+			continue
+		}
+		if kind != "" && ds.Kind.ValueString() != kind {
+			continue
+		}
+		if edgeDeployment != "" && ds.EdgeDeployment.ValueString() != edgeDeployment {
+			continue
+		}
+		filtered = append(filtered, ds)
+	}
+
+	slices.SortFunc(filtered, func(a, b DatasetResourceModel) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	return filtered
+}
@@ -0,0 +1,96 @@
+package axiom
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasetsDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	var resp datasource.SchemaResponse
+	(&DatasetsDataSource{}).Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError())
+}
+
+func TestFilterDatasets(t *testing.T) {
+	t.Parallel()
+
+	dataset := func(name, kind, edgeDeployment string) DatasetResourceModel {
+		return DatasetResourceModel{
+			Name:           types.StringValue(name),
+			Kind:           types.StringValue(kind),
+			EdgeDeployment: types.StringValue(edgeDeployment),
+		}
+	}
+
+	datasets := []DatasetResourceModel{
+		dataset("web-logs", "axiom:events:v1", "cloud.us-east-1.aws"),
+		dataset("api-traces", "otel:traces:v1", "cloud.eu-central-1.aws"),
+		dataset("api-logs", "axiom:events:v1", "cloud.eu-central-1.aws"),
+	}
+
+	names := func(datasets []DatasetResourceModel) []string {
+		result := make([]string, 0, len(datasets))
+		for _, ds := range datasets {
+			result = append(result, ds.Name.ValueString())
+		}
+		return result
+	}
+
+	tests := []struct {
+		name           string
+		nameRe         *regexp.Regexp
+		kind           string
+		edgeDeployment string
+		want           []string
+	}{
+		{
+			name: "no filters",
+			want: []string{"api-logs", "api-traces", "web-logs"},
+		},
+		{
+			name:   "name regex",
+			nameRe: regexp.MustCompile("^api-"),
+			want:   []string{"api-logs", "api-traces"},
+		},
+		{
+			name: "kind",
+			kind: "axiom:events:v1",
+			want: []string{"api-logs", "web-logs"},
+		},
+		{
+			name:           "edge deployment",
+			edgeDeployment: "cloud.eu-central-1.aws",
+			want:           []string{"api-logs", "api-traces"},
+		},
+		{
+			name:           "all filters",
+			nameRe:         regexp.MustCompile("logs$"),
+			kind:           "axiom:events:v1",
+			edgeDeployment: "cloud.eu-central-1.aws",
+			want:           []string{"api-logs"},
+		},
+		{
+			name: "no match",
+			kind: "otel:metrics:v1",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, names(filterDatasets(datasets, tt.nameRe, tt.kind, tt.edgeDeployment)))
+		})
+	}
+}
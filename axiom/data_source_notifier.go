@@ -2,10 +2,11 @@ package axiom
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/axiomhq/axiom-go/axiom"
@@ -22,22 +23,75 @@ type NotifierDataSource struct {
 	client *axiom.Client
 }
 
-func (d *NotifierDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
+// notifierManagedAttributes are the attributes of the notifier resource that
+// only apply to managed notifiers and are left out of the data source.
+var notifierManagedAttributes = []string{"secret_version"}
+
+// notifierSecretDescriptions replace the resource descriptions of the notifier
+// secrets, which refer to their write-only variants, in the data source.
+var notifierSecretDescriptions = map[string]map[string]string{
+	"slack":           {"slack_url": "The slack URL"},
+	"discord":         {"discord_token": "The discord token"},
+	"discord_webhook": {"discord_webhook_url": "The discord webhook URL"},
+	"opsgenie":        {"api_key": "The opsgenie API key"},
+	"pagerduty":       {"routing_key": "The pagerduty routing key"},
+}
 
-	client, ok := req.ProviderData.(*axiom.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected datasource Configure Type",
-			fmt.Sprintf("Expected *axiom.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+// notifierDataSourceModel is the notifier model without the secret version and
+// the write-only secrets, which the data source doesn't expose.
+type notifierDataSourceModel struct {
+	ID         types.String                  `tfsdk:"id"`
+	Name       types.String                  `tfsdk:"name"`
+	Properties *notifierDataSourceProperties `tfsdk:"properties"`
+}
+
+type notifierDataSourceProperties struct {
+	Discord        *notifierDataSourceDiscord        `tfsdk:"discord"`
+	DiscordWebhook *notifierDataSourceDiscordWebhook `tfsdk:"discord_webhook"`
+	Email          *EmailConfig                      `tfsdk:"email"`
+	Opsgenie       *notifierDataSourceOpsGenie       `tfsdk:"opsgenie"`
+	Pagerduty      *notifierDataSourcePagerDuty      `tfsdk:"pagerduty"`
+	Slack          *notifierDataSourceSlack          `tfsdk:"slack"`
+	Webhook        *WebhookConfig                    `tfsdk:"webhook"`
+	CustomWebhook  *notifierDataSourceCustomWebhook  `tfsdk:"custom_webhook"`
+}
+
+type notifierDataSourceSlack struct {
+	SlackURL types.String `tfsdk:"slack_url"`
+}
+
+type notifierDataSourceDiscord struct {
+	DiscordChannel types.String `tfsdk:"discord_channel"`
+	DiscordToken   types.String `tfsdk:"discord_token"`
+}
+
+type notifierDataSourceDiscordWebhook struct {
+	DiscordWebhookURL types.String `tfsdk:"discord_webhook_url"`
+}
+
+type notifierDataSourceOpsGenie struct {
+	APIKey types.String `tfsdk:"api_key"`
+	IsEU   types.Bool   `tfsdk:"is_eu"`
+}
+
+type notifierDataSourcePagerDuty struct {
+	RoutingKey types.String `tfsdk:"routing_key"`
+	Token      types.String `tfsdk:"token"`
+}
+
+type notifierDataSourceCustomWebhook struct {
+	URL     types.String `tfsdk:"url"`
+	Headers types.Map    `tfsdk:"headers"`
+	Body    types.String `tfsdk:"body"`
+}
 
+func (d *NotifierDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Data Source", &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	d.client = client
+	d.client = data.client
 }
 
 func (d *NotifierDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -49,11 +103,30 @@ func (d *NotifierDataSource) Schema(ctx context.Context, _ datasource.SchemaRequ
 	var resourceResp resource.SchemaResponse
 	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
 
+	for _, attribute := range notifierManagedAttributes {
+		delete(resourceResp.Schema.Attributes, attribute)
+	}
+
+	properties := resourceResp.Schema.Attributes["properties"].(resourceschema.SingleNestedAttribute)
+	for notifierType, attribute := range properties.Attributes {
+		nested := attribute.(resourceschema.SingleNestedAttribute)
+		for name, nestedAttribute := range nested.Attributes {
+			if nestedAttribute.IsWriteOnly() {
+				delete(nested.Attributes, name)
+			}
+		}
+		for name, description := range notifierSecretDescriptions[notifierType] {
+			secret := nested.Attributes[name].(resourceschema.StringAttribute)
+			secret.MarkdownDescription = description
+			nested.Attributes[name] = secret
+		}
+	}
+
 	resp.Schema = frameworkDatasourceSchemaFromFrameworkResourceSchema(resourceResp.Schema)
 }
 
 func (d *NotifierDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var plan NotifierResourceModel
+	var plan notifierDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
 
@@ -69,5 +142,59 @@ func (d *NotifierDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenNotifier(*notifier))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notifierDataSourceModelFromResource(flattenNotifier(*notifier)))...)
+}
+
+func notifierDataSourceModelFromResource(notifier NotifierResourceModel) notifierDataSourceModel {
+	model := notifierDataSourceModel{
+		ID:   notifier.ID,
+		Name: notifier.Name,
+	}
+	if notifier.Properties == nil {
+		return model
+	}
+
+	properties := notifier.Properties
+	model.Properties = &notifierDataSourceProperties{
+		Email:   properties.Email,
+		Webhook: properties.Webhook,
+	}
+
+	if properties.Slack != nil {
+		model.Properties.Slack = &notifierDataSourceSlack{
+			SlackURL: properties.Slack.SlackURL,
+		}
+	}
+	if properties.Discord != nil {
+		model.Properties.Discord = &notifierDataSourceDiscord{
+			DiscordChannel: properties.Discord.DiscordChannel,
+			DiscordToken:   properties.Discord.DiscordToken,
+		}
+	}
+	if properties.DiscordWebhook != nil {
+		model.Properties.DiscordWebhook = &notifierDataSourceDiscordWebhook{
+			DiscordWebhookURL: properties.DiscordWebhook.DiscordWebhookURL,
+		}
+	}
+	if properties.Opsgenie != nil {
+		model.Properties.Opsgenie = &notifierDataSourceOpsGenie{
+			APIKey: properties.Opsgenie.APIKey,
+			IsEU:   properties.Opsgenie.IsEU,
+		}
+	}
+	if properties.Pagerduty != nil {
+		model.Properties.Pagerduty = &notifierDataSourcePagerDuty{
+			RoutingKey: properties.Pagerduty.RoutingKey,
+			Token:      properties.Pagerduty.Token,
+		}
+	}
+	if properties.CustomWebhook != nil {
+		model.Properties.CustomWebhook = &notifierDataSourceCustomWebhook{
+			URL:     properties.CustomWebhook.URL,
+			Headers: properties.CustomWebhook.Headers,
+			Body:    properties.CustomWebhook.Body,
+		}
+	}
+
+	return model
 }
@@ -0,0 +1,56 @@
+package axiom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+func TestNotifierDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var resp datasource.SchemaResponse
+	(&NotifierDataSource{}).Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+	assert.NotContains(t, resp.Schema.Attributes, "secret_version")
+
+	properties := resp.Schema.Attributes["properties"].(schema.SingleNestedAttribute)
+	for notifierType, attribute := range properties.Attributes {
+		for name, nested := range attribute.(schema.SingleNestedAttribute).Attributes {
+			assert.NotRegexp(t, `_wo$`, name, "properties.%s", notifierType)
+			assert.NotContains(t, nested.GetMarkdownDescription(), "_wo", "properties.%s.%s", notifierType, name)
+		}
+	}
+
+	slack := properties.Attributes["slack"].(schema.SingleNestedAttribute)
+	assert.Equal(t, "The slack URL", slack.Attributes["slack_url"].GetMarkdownDescription())
+
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+	notifier := flattenNotifier(axiom.Notifier{
+		ID:   "notifier-id",
+		Name: "alerts",
+		Properties: axiom.NotifierProperties{
+			Slack: &axiom.SlackConfig{SlackURL: "https://hooks.slack.com/services/secret"},
+		},
+	})
+	require.False(t, state.Set(ctx, notifierDataSourceModelFromResource(notifier)).HasError())
+
+	var got notifierDataSourceModel
+	require.False(t, state.Get(ctx, &got).HasError())
+	assert.Equal(t, "https://hooks.slack.com/services/secret", got.Properties.Slack.SlackURL.ValueString())
+}
@@ -0,0 +1,206 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/axiomhq/axiom-go/axiom"
+	aplquery "github.com/axiomhq/axiom-go/axiom/query"
+)
+
+// datasetsBasePath is the base path of the dataset endpoints that axiom-go
+// doesn't wrap yet.
+const datasetsBasePath = "/v2/datasets"
+
+// datasetField is a field of a dataset as returned by the fields endpoint.
+type datasetField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Unit        string `json:"unit"`
+	Description string `json:"description"`
+	Hidden      bool   `json:"hidden"`
+}
+
+// listDatasetFields lists the fields of the dataset identified by the given id.
+func listDatasetFields(ctx context.Context, client *axiom.Client, id string) ([]datasetField, error) {
+	path, err := url.JoinPath(datasetsBasePath, id, "fields")
+	if err != nil {
+		return nil, err
+	}
+
+	var res []datasetField
+	if err := client.Call(ctx, http.MethodGet, path, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// datasetFieldUpdateRequest updates the metadata of a dataset field.
+type datasetFieldUpdateRequest struct {
+	Description string `json:"description"`
+	Unit        string `json:"unit"`
+	Hidden      bool   `json:"hidden"`
+}
+
+// getDatasetField returns the field with the given name of the dataset
+// identified by the given id.
+func getDatasetField(ctx context.Context, client *axiom.Client, id, name string) (*datasetField, error) {
+	path, err := url.JoinPath(datasetsBasePath, id, "fields", name)
+	if err != nil {
+		return nil, err
+	}
+
+	var res datasetField
+	if err := client.Call(ctx, http.MethodGet, path, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// updateDatasetField updates the metadata of the field with the given name of
+// the dataset identified by the given id.
+func updateDatasetField(ctx context.Context, client *axiom.Client, id, name string, req datasetFieldUpdateRequest) (*datasetField, error) {
+	path, err := url.JoinPath(datasetsBasePath, id, "fields", name)
+	if err != nil {
+		return nil, err
+	}
+
+	var res datasetField
+	if err := client.Call(ctx, http.MethodPut, path, req, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// edgeConfig is the edge endpoint axiom-go ingests into instead of the API,
+// taken from the same environment variables the client reads.
+type edgeConfig struct {
+	url    *url.URL
+	domain string
+}
+
+// edgeConfigFromEnvironment reads AXIOM_EDGE_URL and AXIOM_EDGE. Invalid
+// values are ignored here, as they already fail creating the client.
+func edgeConfigFromEnvironment() edgeConfig {
+	var config edgeConfig
+	if v := os.Getenv("AXIOM_EDGE_URL"); v != "" {
+		config.url, _ = url.ParseRequestURI(v)
+	}
+	config.domain = os.Getenv("AXIOM_EDGE")
+
+	return config
+}
+
+// ingestURL returns the edge ingest URL of the dataset, following the rules of
+// axiom-go: a custom path on the edge URL is used as is, otherwise the edge
+// ingest path of the dataset is resolved against the edge URL or domain. It
+// returns nil when no edge endpoint is configured.
+func (c edgeConfig) ingestURL(dataset string) *url.URL {
+	if c.url != nil {
+		if strings.TrimSuffix(c.url.Path, "/") != "" {
+			return c.url
+		}
+		return c.url.ResolveReference(&url.URL{Path: "/v1/ingest/" + dataset})
+	}
+
+	if c.domain != "" {
+		return &url.URL{Scheme: "https", Host: c.domain, Path: "/v1/ingest/" + dataset}
+	}
+
+	return nil
+}
+
+// datasetIngestURL returns the URL the client ingests events into the dataset
+// with. Like axiom-go, it prefers a configured edge endpoint over the API. A
+// dataset on an edge deployment can't be ingested into through the API, so
+// without an edge endpoint no URL is returned for it.
+func datasetIngestURL(ctx context.Context, client *axiom.Client, edge edgeConfig, dataset *axiom.Dataset) (string, error) {
+	if edgeURL := edge.ingestURL(dataset.ID); edgeURL != nil {
+		return edgeURL.String(), nil
+	}
+
+	if dataset.EdgeDeployment != "" {
+		return "", nil
+	}
+
+	path, err := url.JoinPath("/v1/datasets", dataset.ID, "ingest")
+	if err != nil {
+		return "", err
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return req.URL.String(), nil
+}
+
+// datasetStatsPath is the path of the endpoint reporting the usage of every
+// dataset of the organization.
+const datasetStatsPath = "/v1/datasets/_stats"
+
+// datasetStats is the usage of a dataset as returned by the stats endpoint.
+type datasetStats struct {
+	Name            string    `json:"name"`
+	NumEvents       uint64    `json:"numEvents"`
+	InputBytes      uint64    `json:"inputBytes"`
+	CompressedBytes uint64    `json:"compressedBytes"`
+	MinTime         time.Time `json:"minTime"`
+	MaxTime         time.Time `json:"maxTime"`
+}
+
+// listDatasetStats lists the usage of every dataset of the organization.
+func listDatasetStats(ctx context.Context, client *axiom.Client) ([]datasetStats, error) {
+	var res struct {
+		Datasets []datasetStats `json:"datasets"`
+	}
+	if err := client.Call(ctx, http.MethodGet, datasetStatsPath, nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Datasets, nil
+}
+
+// lastIngestWindow is how far before the newest event the query for the last
+// ingest time looks, which bounds the data it scans.
+const lastIngestWindow = 24 * time.Hour
+
+// queryLastIngestTime returns the latest ingest time (_sysTime) of the events
+// of a dataset, which the stats endpoint doesn't report. Only events within
+// lastIngestWindow of the newest event are queried, so events backdated
+// further are missed. A zero time is returned when the query yields no value.
+func queryLastIngestTime(ctx context.Context, client *axiom.Client, stats datasetStats) (time.Time, error) {
+	apl := fmt.Sprintf("['%s'] | summarize last_ingest_time = max(_sysTime)", stats.Name)
+
+	start := stats.MaxTime.Add(-lastIngestWindow)
+	if start.Before(stats.MinTime) {
+		start = stats.MinTime
+	}
+	result, err := client.Datasets.Query(ctx, apl,
+		aplquery.SetStartTime(start),
+		aplquery.SetEndTime(stats.MaxTime.Add(time.Second)),
+	)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(result.Tables) == 0 || len(result.Tables[0].Columns) == 0 || len(result.Tables[0].Columns[0]) == 0 {
+		return time.Time{}, nil
+	}
+
+	value, ok := result.Tables[0].Columns[0][0].(string)
+	if !ok || value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,207 @@
+package axiom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the duration type fully satisfies framework interfaces.
+var (
+	_ basetypes.StringTypable                    = durationType{}
+	_ basetypes.StringValuableWithSemanticEquals = durationValue{}
+	_ xattr.ValidateableAttribute                = durationValue{}
+)
+
+// isoDurationRegexp matches the ISO 8601 durations made up of weeks, days,
+// hours, minutes and seconds. Years and months are not supported, as their
+// length varies.
+var isoDurationRegexp = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// durationType is a string type holding a duration, either as a Go duration
+// such as "1h30m" or as an ISO 8601 duration such as "PT1H30M". Values are
+// semantically equal when they describe the same duration.
+type durationType struct {
+	basetypes.StringType
+}
+
+func (t durationType) Equal(o attr.Type) bool {
+	other, ok := o.(durationType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t durationType) String() string {
+	return "durationType"
+}
+
+func (t durationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return durationValue{StringValue: in}, nil
+}
+
+func (t durationType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t durationType) ValueType(_ context.Context) attr.Value {
+	return durationValue{}
+}
+
+// durationValue is a value of durationType.
+type durationValue struct {
+	basetypes.StringValue
+}
+
+// newDurationValue returns the value of the duration, formatted as a Go
+// duration.
+func newDurationValue(d time.Duration) durationValue {
+	return durationValue{StringValue: basetypes.NewStringValue(formatDuration(d))}
+}
+
+func (v durationValue) Equal(o attr.Value) bool {
+	other, ok := o.(durationValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v durationValue) Type(_ context.Context) attr.Type {
+	return durationType{}
+}
+
+// StringSemanticEquals reports whether both values describe the same
+// duration, so that "PT5M" in the configuration matches "5m" read back from
+// the API.
+func (v durationValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(durationValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := parseDuration(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	current, err := parseDuration(newValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+
+	return prior == current, nil
+}
+
+func (v durationValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := parseDuration(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a Go duration such as 5m or an ISO 8601 duration such as PT5M, got %q: %s", v.ValueString(), err),
+		)
+	}
+}
+
+// ValueDuration returns the duration of the value.
+func (v durationValue) ValueDuration() (time.Duration, error) {
+	return parseDuration(v.ValueString())
+}
+
+// parseDuration parses a Go duration or an ISO 8601 duration. Negative
+// durations are rejected.
+func parseDuration(s string) (time.Duration, error) {
+	if !strings.HasPrefix(s, "P") {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, err
+		}
+		if d < 0 {
+			return 0, errors.New("duration must not be negative")
+		}
+		return d, nil
+	}
+
+	match := isoDurationRegexp.FindStringSubmatch(s)
+	if match == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, errors.New("invalid ISO 8601 duration, only weeks, days, hours, minutes and seconds are supported")
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute} {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(match[i+1], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+
+	if match[5] != "" {
+		seconds, err := strconv.ParseFloat(match[5], 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(seconds * float64(time.Second))
+	}
+
+	return d, nil
+}
+
+// formatDuration formats the duration as a Go duration without zero units,
+// such as "1h30m" instead of "1h30m0s".
+func formatDuration(d time.Duration) string {
+	if d == 0 || d%time.Second != 0 {
+		return d.String()
+	}
+
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
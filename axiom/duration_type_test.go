@@ -0,0 +1,111 @@
+package axiom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "5m", want: 5 * time.Minute},
+		{input: "1h30m", want: 90 * time.Minute},
+		{input: "0s", want: 0},
+		{input: "PT5M", want: 5 * time.Minute},
+		{input: "PT1H30M", want: 90 * time.Minute},
+		{input: "PT30S", want: 30 * time.Second},
+		{input: "PT1.5S", want: 1500 * time.Millisecond},
+		{input: "P1D", want: 24 * time.Hour},
+		{input: "P1W", want: 7 * 24 * time.Hour},
+		{input: "P1DT12H", want: 36 * time.Hour},
+		{input: "-5m", wantErr: true},
+		{input: "5", wantErr: true},
+		{input: "P", wantErr: true},
+		{input: "PT", wantErr: true},
+		{input: "P1M", wantErr: true},
+		{input: "P1Y", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDuration(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input time.Duration
+		want  string
+	}{
+		{input: 0, want: "0s"},
+		{input: 30 * time.Second, want: "30s"},
+		{input: 5 * time.Minute, want: "5m"},
+		{input: 90 * time.Second, want: "1m30s"},
+		{input: time.Hour, want: "1h"},
+		{input: 90 * time.Minute, want: "1h30m"},
+		{input: time.Hour + time.Second, want: "1h0m1s"},
+		{input: 1500 * time.Millisecond, want: "1.5s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, formatDuration(tt.input))
+
+			parsed, err := parseDuration(tt.want)
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, parsed)
+		})
+	}
+}
+
+func TestDurationValueStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		prior string
+		new   string
+		want  bool
+	}{
+		{name: "identical", prior: "5m", new: "5m", want: true},
+		{name: "iso and go", prior: "PT5M", new: "5m", want: true},
+		{name: "different units", prior: "1h", new: "60m", want: true},
+		{name: "different durations", prior: "5m", new: "10m", want: false},
+		{name: "invalid prior", prior: "soon", new: "5m", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			prior := durationValue{StringValue: types.StringValue(tt.prior)}
+			got, diags := prior.StringSemanticEquals(context.Background(), durationValue{StringValue: types.StringValue(tt.new)})
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
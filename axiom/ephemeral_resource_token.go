@@ -0,0 +1,255 @@
+package axiom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &TokenEphemeralResource{}
+)
+
+// ephemeralTokenIDKey is the private data key the ID of the created token is
+// stored under, so it can be revoked when Terraform closes the resource.
+const ephemeralTokenIDKey = "token_id"
+
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{}
+}
+
+// TokenEphemeralResource defines the ephemeral resource implementation.
+type TokenEphemeralResource struct {
+	client *axiom.Client
+}
+
+// TokenEphemeralResourceModel describes the ephemeral resource data model.
+type TokenEphemeralResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+	TTL                 types.String `tfsdk:"ttl"`
+	DatasetCapabilities types.Map    `tfsdk:"dataset_capabilities"`
+	OrgCapabilities     types.Object `tfsdk:"org_capabilities"`
+	Token               types.String `tfsdk:"token"`
+}
+
+func (r *TokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+func (r *TokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	// The capabilities share their schema with the managed token resource.
+	var tokenResource TokenResource
+	var tokenResourceResp resource.SchemaResponse
+	tokenResource.Schema(ctx, resource.SchemaRequest{}, &tokenResourceResp)
+
+	capabilities := convertEphemeralAttributes(map[string]resourceschema.Attribute{
+		"dataset_capabilities": tokenResourceResp.Schema.Attributes["dataset_capabilities"],
+		"org_capabilities":     tokenResourceResp.Schema.Attributes["org_capabilities"],
+	})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a short-lived API token that is revoked once Terraform no longer needs it. " +
+			"The token is never persisted to the plan or state and can only be referenced from write-only " +
+			"or provider configuration attributes.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token value to be used in API calls",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the token",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the token",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the token",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The time when the token expires. Must be in RFC3339 format. Exactly one of expires_at or ttl must be set",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("ttl")),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the token remains valid after it is created (for example: 30m, 1h). Exactly one of expires_at or ttl must be set",
+			},
+			"dataset_capabilities": capabilities["dataset_capabilities"],
+			"org_capabilities":     capabilities["org_capabilities"],
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Ephemeral Resource", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config TokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	expiresAt, diags := resolveEphemeralTokenExpiry(config, time.Now())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	tokenReq, diags := buildCreateTokenRequest(ctx, ephemeralTokenToResourceModel(config, expiresAt))
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	token, err := r.client.Tokens.Create(ctx, tokenReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token, got error: %s", err))
+		return
+	}
+
+	// Terraform doesn't close ephemeral resources that failed to open, so the
+	// token is revoked right away if it can't be handed over.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.Tokens.Delete(ctx, token.ID); err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("Failed to revoke token", fmt.Sprintf("Unable to revoke token %s after opening the ephemeral resource failed, got error: %s", token.ID, err))
+		}
+	}()
+
+	tokenID, err := json.Marshal(token.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to store token ID, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralTokenIDKey, tokenID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue(token.ID)
+	config.Token = types.StringValue(token.Token)
+	config.ExpiresAt = expiresAt
+	if !token.ExpiresAt.IsZero() {
+		config.ExpiresAt = types.StringValue(token.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+func (r *TokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	rawTokenID, diags := req.Private.GetKey(ctx, ephemeralTokenIDKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || rawTokenID == nil {
+		return
+	}
+
+	var tokenID string
+	if err := json.Unmarshal(rawTokenID, &tokenID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read token ID, got error: %s", err))
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	if err := r.client.Tokens.Delete(ctx, tokenID); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to revoke token", err.Error())
+	}
+}
+
+// resolveEphemeralTokenExpiry returns the configured expiry, or the expiry
+// derived from the ttl relative to now.
+func resolveEphemeralTokenExpiry(config TokenEphemeralResourceModel, now time.Time) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config.TTL.IsNull() || config.TTL.IsUnknown() {
+		return config.ExpiresAt, diags
+	}
+
+	ttl, err := time.ParseDuration(config.TTL.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ttl"),
+			"Invalid TTL",
+			fmt.Sprintf("Expected a valid Go duration such as 30m or 1h, got %q: %s", config.TTL.ValueString(), err),
+		)
+		return types.StringNull(), diags
+	}
+
+	if ttl <= 0 {
+		diags.AddAttributeError(
+			path.Root("ttl"),
+			"Invalid TTL",
+			"TTL must be greater than zero.",
+		)
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(now.UTC().Add(ttl).Format(time.RFC3339)), diags
+}
+
+// ephemeralTokenToResourceModel maps the ephemeral token configuration onto
+// the managed resource model so both create tokens the same way.
+func ephemeralTokenToResourceModel(config TokenEphemeralResourceModel, expiresAt types.String) TokensResourceModel {
+	datasetCapabilities := config.DatasetCapabilities
+	if datasetCapabilities.IsNull() || datasetCapabilities.IsUnknown() {
+		datasetCapabilities = types.MapValueMust(
+			types.ObjectType{
+				AttrTypes: DatasetCapabilities{}.Types(),
+			},
+			map[string]attr.Value{},
+		)
+	}
+
+	return TokensResourceModel{
+		Name:                config.Name,
+		Description:         config.Description,
+		ExpiresAt:           expiresAt,
+		DatasetCapabilities: datasetCapabilities,
+		OrgCapabilities:     config.OrgCapabilities,
+	}
+}
@@ -0,0 +1,154 @@
+package axiom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+func TestTokenEphemeralResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	var resp ephemeral.SchemaResponse
+	(&TokenEphemeralResource{}).Schema(context.Background(), ephemeral.SchemaRequest{}, &resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError())
+
+	assert.True(t, resp.Schema.Attributes["token"].IsSensitive())
+	assert.True(t, resp.Schema.Attributes["dataset_capabilities"].IsOptional())
+	assert.True(t, resp.Schema.Attributes["org_capabilities"].IsOptional())
+}
+
+func TestResolveEphemeralTokenExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("uses expires_at", func(t *testing.T) {
+		t.Parallel()
+
+		config := TokenEphemeralResourceModel{
+			ExpiresAt: types.StringValue("2025-02-01T00:00:00Z"),
+			TTL:       types.StringNull(),
+		}
+
+		expiresAt, diags := resolveEphemeralTokenExpiry(config, now)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "2025-02-01T00:00:00Z", expiresAt.ValueString())
+	})
+
+	t.Run("derives expiry from ttl", func(t *testing.T) {
+		t.Parallel()
+
+		config := TokenEphemeralResourceModel{
+			ExpiresAt: types.StringNull(),
+			TTL:       types.StringValue("1h"),
+		}
+
+		expiresAt, diags := resolveEphemeralTokenExpiry(config, now)
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, "2025-01-02T04:04:05Z", expiresAt.ValueString())
+	})
+
+	t.Run("rejects invalid ttl", func(t *testing.T) {
+		t.Parallel()
+
+		config := TokenEphemeralResourceModel{TTL: types.StringValue("soon")}
+
+		_, diags := resolveEphemeralTokenExpiry(config, now)
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid TTL", diags[0].Summary())
+	})
+
+	t.Run("rejects non-positive ttl", func(t *testing.T) {
+		t.Parallel()
+
+		config := TokenEphemeralResourceModel{TTL: types.StringValue("0s")}
+
+		_, diags := resolveEphemeralTokenExpiry(config, now)
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "greater than zero")
+	})
+}
+
+func TestEphemeralTokenToResourceModel(t *testing.T) {
+	t.Parallel()
+
+	config := TokenEphemeralResourceModel{
+		Name:                types.StringValue("short-lived"),
+		Description:         types.StringNull(),
+		DatasetCapabilities: types.MapNull(types.ObjectType{AttrTypes: DatasetCapabilities{}.Types()}),
+		OrgCapabilities:     types.ObjectNull(OrgCapabilities{}.Types()),
+	}
+
+	tokenReq, diags := buildCreateTokenRequest(context.Background(), ephemeralTokenToResourceModel(config, types.StringValue("2025-02-01T00:00:00Z")))
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, "short-lived", tokenReq.Name)
+	assert.Equal(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), tokenReq.ExpiresAt)
+	assert.Empty(t, tokenReq.DatasetCapabilities)
+	assert.Equal(t, axiom.OrganisationCapabilities{}, tokenReq.OrganisationCapabilities)
+}
+
+func TestTokenEphemeralResourceOpen_RevokesTokenOnFailure(t *testing.T) {
+	t.Parallel()
+
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/v2/tokens", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"token-id","name":"short-lived","token":"xaat-secret"}`))
+		case http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	r := &TokenEphemeralResource{client: newTestClient(t, srv.URL)}
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, config.Set(ctx, TokenEphemeralResourceModel{
+		ID:                  types.StringNull(),
+		Name:                types.StringValue("short-lived"),
+		Description:         types.StringNull(),
+		ExpiresAt:           types.StringNull(),
+		TTL:                 types.StringValue("1h"),
+		DatasetCapabilities: types.MapNull(types.ObjectType{AttrTypes: DatasetCapabilities{}.Types()}),
+		OrgCapabilities:     types.ObjectNull(OrgCapabilities{}.Types()),
+		Token:               types.StringNull(),
+	}).HasError())
+
+	// The private data is left uninitialized, so storing the token ID fails.
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: config.Raw.Copy()},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, []string{"/v2/tokens/token-id"}, deleted)
+}
@@ -0,0 +1,275 @@
+package axiom
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem API calls are logged under.
+	// Use TF_LOG_PROVIDER_AXIOM_HTTP to control its level independently.
+	// Bodies are only logged at debug level or below.
+	httpLogSubsystem = "axiom_http"
+
+	maxLoggedBodyBytes = 4096
+	redactedValue      = "***"
+)
+
+// redactedHeaders are never written to the logs.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// redactedBodyFields are masked wherever they appear in a JSON request or
+// response body. Keys are compared case-insensitively with underscores
+// removed, so both "slack_url" and "slackUrl" match "slackurl".
+var redactedBodyFields = map[string]struct{}{
+	"slackurl":          {},
+	"apikey":            {},
+	"routingkey":        {},
+	"discordtoken":      {},
+	"discordwebhookurl": {},
+	"token":             {},
+	"headers":           {},
+}
+
+// loggingTransport is a http.RoundTripper that logs every API call with its
+// secrets redacted. Bodies are only read when debug logging is enabled for
+// the subsystem, and never beyond maxLoggedBodyBytes.
+type loggingTransport struct {
+	next      http.RoundTripper
+	logBodies bool
+}
+
+func newLoggingTransport(next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		next:      next,
+		logBodies: httpDebugLoggingEnabled(),
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", httpLogSubsystem))
+
+	requestFields := map[string]any{
+		"method":  req.Method,
+		"path":    req.URL.RequestURI(),
+		"headers": redactHeaders(req.Header),
+	}
+	if t.logBodies {
+		requestFields["body"] = requestBodyForLog(req)
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending Axiom API request", requestFields)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "Axiom API request failed", map[string]any{
+			"method":     req.Method,
+			"path":       req.URL.RequestURI(),
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+		return resp, err
+	}
+
+	responseFields := map[string]any{
+		"method":     req.Method,
+		"path":       req.URL.RequestURI(),
+		"status":     resp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+		"headers":    redactHeaders(resp.Header),
+	}
+	if t.logBodies {
+		responseFields["body"] = responseBodyForLog(resp)
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received Axiom API response", responseFields)
+
+	return resp, nil
+}
+
+// httpDebugLoggingEnabled reports whether API calls are logged at debug level
+// or below. The most specific of the Terraform log level environment variables
+// that is set wins, mirroring how the levels of the provider loggers resolve.
+func httpDebugLoggingEnabled() bool {
+	for _, name := range []string{"TF_LOG_PROVIDER_AXIOM_HTTP", "TF_LOG_PROVIDER_AXIOM", "TF_LOG_PROVIDER", "TF_LOG"} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+
+		// TF_LOG=JSON logs at trace level.
+		if strings.EqualFold(value, "json") {
+			return true
+		}
+
+		level := hclog.LevelFromString(value)
+		return level != hclog.NoLevel && level <= hclog.Debug
+	}
+
+	return false
+}
+
+// requestBodyForLog returns the redacted request body without consuming it.
+// Encoded bodies, such as compressed ingest payloads, and streamed bodies that
+// can't be re-read are skipped, so they aren't encoded a second time.
+func requestBodyForLog(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+
+	if !isTextBody(req.Header) {
+		return "<encoded body omitted>"
+	}
+
+	if req.GetBody == nil {
+		return "<streamed body omitted>"
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "<unreadable body omitted>"
+	}
+	defer body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(body, maxLoggedBodyBytes+1))
+	if err != nil {
+		return "<unreadable body omitted>"
+	}
+
+	return redactBody(raw)
+}
+
+// responseBodyForLog returns the redacted start of the response body. Only up
+// to maxLoggedBodyBytes are read, and those are put back in front of the rest
+// of the body so the caller still receives all of it.
+func responseBodyForLog(resp *http.Response) string {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return ""
+	}
+
+	if !isTextBody(resp.Header) {
+		return "<encoded body omitted>"
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodyBytes+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(raw), resp.Body), resp.Body}
+	if err != nil {
+		return "<unreadable body omitted>"
+	}
+
+	return redactBody(raw)
+}
+
+// isTextBody reports whether the headers describe an unencoded JSON or text
+// body, which is the only kind of body that is logged.
+func isTextBody(header http.Header) bool {
+	if encoding := header.Get("Content-Encoding"); encoding != "" && !strings.EqualFold(encoding, "identity") {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(mediaType, "text/") ||
+		mediaType == "application/json" ||
+		mediaType == "application/x-ndjson" ||
+		strings.HasSuffix(mediaType, "+json")
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		redacted[name] = strings.Join(values, ", ")
+	}
+
+	for _, name := range redactedHeaders {
+		if _, ok := redacted[http.CanonicalHeaderKey(name)]; ok {
+			redacted[http.CanonicalHeaderKey(name)] = redactedValue
+		}
+	}
+
+	return redacted
+}
+
+// redactBody masks known secret fields of a body and truncates the result.
+// JSON bodies are redacted field by field. Anything else, including JSON cut
+// off at the read limit, has secret fields and token-like values masked as
+// text, since error pages may echo them back.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if encoded, err := json.Marshal(redactValue(decoded)); err == nil {
+			body = encoded
+		}
+	} else {
+		body = redactText(body)
+	}
+
+	if len(body) > maxLoggedBodyBytes {
+		return fmt.Sprintf("%s... (truncated)", body[:maxLoggedBodyBytes])
+	}
+
+	return string(body)
+}
+
+var (
+	// redactedTextFieldRe matches a secret field and its string or flat object
+	// value in text that couldn't be decoded as JSON.
+	redactedTextFieldRe = regexp.MustCompile(`(?i)("(?:slack_?url|api_?key|routing_?key|discord_?token|discord_?webhook_?url|token|headers)"\s*:\s*)("(?:[^"\\]|\\.)*"?|\{[^{}]*\}?)`)
+
+	// redactedTextTokenRe matches Axiom tokens and bearer credentials wherever
+	// they appear in text.
+	redactedTextTokenRe = regexp.MustCompile(`(?i)\bxa[ap]t-[a-z0-9-]+|\bbearer\s+[^\s"',;]+`)
+)
+
+func redactText(body []byte) []byte {
+	body = redactedTextFieldRe.ReplaceAll(body, []byte(`${1}"`+redactedValue+`"`))
+	return redactedTextTokenRe.ReplaceAll(body, []byte(redactedValue))
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			if isRedactedBodyField(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(nested)
+		}
+		return v
+	case []any:
+		for i, nested := range v {
+			v[i] = redactValue(nested)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isRedactedBodyField(key string) bool {
+	_, ok := redactedBodyFields[strings.ToLower(strings.ReplaceAll(key, "_", ""))]
+	return ok
+}
@@ -0,0 +1,220 @@
+package axiom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	maxMaxRetries       = 10
+	defaultRetryMinWait = time.Second
+	defaultRetryMaxWait = 30 * time.Second
+
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+)
+
+var errBodyNotRewindable = errors.New("request body cannot be rewound")
+
+// retryConfig controls how failed API calls are retried.
+type retryConfig struct {
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+// retryTransport is a http.RoundTripper that retries rate limited and failed
+// requests with exponential backoff. Rate limited requests (429) were never
+// processed by the server and are retried for every method. Server errors (5xx)
+// are only retried for idempotent methods, so a create that may have succeeded
+// is never sent twice.
+type retryTransport struct {
+	next   http.RoundTripper
+	config retryConfig
+
+	// now and sleep are replaceable in tests.
+	now   func() time.Time
+	sleep func(req *http.Request, d time.Duration) error
+}
+
+func newRetryTransport(next http.RoundTripper, config retryConfig) *retryTransport {
+	return &retryTransport{
+		next:   next,
+		config: config,
+		now:    time.Now,
+		sleep:  sleepContext,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.config.maxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		body, rewindErr := rewindRequestBody(req)
+		if rewindErr != nil {
+			// The body can't be sent again, so hand back what we have.
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		fields := map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if address := resourceAddressFromContext(req.Context()); address != "" {
+			fields["resource"] = address
+		}
+		if resp != nil {
+			fields["status"] = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		tflog.Warn(req.Context(), "Retrying Axiom API request", fields)
+
+		if err := t.sleep(req, wait); err != nil {
+			return nil, err
+		}
+
+		attemptReq = req.Clone(req.Context())
+		attemptReq.Body = body
+	}
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotentMethod(req.Method)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return isIdempotentMethod(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the next attempt. Server provided
+// hints (Retry-After or an exhausted rate limit window) take precedence over
+// the exponential delay, but never exceed the configured maximum wait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	// Clamp in float64 before converting, as a large attempt would overflow
+	// time.Duration and turn the wait negative.
+	exponential := math.Min(float64(t.config.minWait)*math.Pow(2, float64(attempt)), float64(t.config.maxWait))
+	wait := time.Duration(exponential)
+
+	if resp != nil {
+		if hint := t.serverWaitHint(resp); hint > wait {
+			wait = hint
+		}
+	}
+
+	if wait > t.config.maxWait {
+		wait = t.config.maxWait
+	}
+
+	return wait
+}
+
+func (t *retryTransport) serverWaitHint(resp *http.Response) time.Duration {
+	if v := resp.Header.Get(headerRetryAfter); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return at.Sub(t.now())
+		}
+	}
+
+	if resp.Header.Get(headerRateLimitRemaining) == "0" {
+		if reset, err := strconv.ParseInt(resp.Header.Get(headerRateLimitReset), 10, 64); err == nil && reset > 0 {
+			return time.Unix(reset, 0).Sub(t.now())
+		}
+	}
+
+	return 0
+}
+
+type resourceAddressKey struct{}
+
+// withResourceAddress records the resource an operation acts on, so retries of
+// its API calls can be attributed to it in the logs.
+func withResourceAddress(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, resourceAddressKey{}, address)
+}
+
+func resourceAddressFromContext(ctx context.Context) string {
+	address, _ := ctx.Value(resourceAddressKey{}).(string)
+	return address
+}
+
+// resourceAddress identifies a resource by its type and ID. Terraform does not
+// pass the configuration address (such as axiom_dataset.logs) to providers,
+// so the ID is the most specific reference available. Before an ID is known,
+// for example while creating, only the type is returned.
+func resourceAddress(typeName string, id types.String) string {
+	if id.IsNull() || id.IsUnknown() || id.ValueString() == "" {
+		return typeName
+	}
+
+	return fmt.Sprintf("%s (id %q)", typeName, id.ValueString())
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func rewindRequestBody(req *http.Request) (io.ReadCloser, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req.Body, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errBodyNotRewindable
+	}
+
+	return req.GetBody()
+}
+
+func sleepContext(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
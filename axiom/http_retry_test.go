@@ -0,0 +1,232 @@
+package axiom
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryTransport(maxRetries int) (*retryTransport, *[]time.Duration) {
+	var waits []time.Duration
+
+	transport := newRetryTransport(http.DefaultTransport, retryConfig{
+		maxRetries: maxRetries,
+		minWait:    time.Second,
+		maxWait:    10 * time.Second,
+	})
+	transport.sleep = func(_ *http.Request, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	return transport, &waits
+}
+
+func TestRetryTransport_RetriesRateLimitedRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"monitor"}`, string(body))
+
+		if calls.Add(1) < 3 {
+			w.Header().Set(headerRetryAfter, "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	transport, waits := newTestRetryTransport(3)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"monitor"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, []time.Duration{2 * time.Second, 2 * time.Second}, *waits)
+}
+
+func TestRetryTransport_DoesNotRetryNonIdempotentServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	transport, _ := newTestRetryTransport(3)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_RetriesIdempotentServerErrorsUntilLimit(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	transport, waits := newTestRetryTransport(2)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *waits)
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	transport, _ := newTestRetryTransport(3)
+	transport.now = func() time.Time { return now }
+
+	tests := []struct {
+		name    string
+		attempt int
+		header  http.Header
+		want    time.Duration
+	}{
+		{
+			name:    "exponential without hints",
+			attempt: 2,
+			header:  http.Header{},
+			want:    4 * time.Second,
+		},
+		{
+			name:    "capped at max wait",
+			attempt: 8,
+			header:  http.Header{},
+			want:    10 * time.Second,
+		},
+		{
+			name:    "large attempt does not overflow",
+			attempt: 100,
+			header:  http.Header{},
+			want:    10 * time.Second,
+		},
+		{
+			name:    "retry-after seconds",
+			attempt: 0,
+			header:  http.Header{headerRetryAfter: []string{"5"}},
+			want:    5 * time.Second,
+		},
+		{
+			name:    "retry-after date",
+			attempt: 0,
+			header:  http.Header{headerRetryAfter: []string{now.Add(7 * time.Second).UTC().Format(http.TimeFormat)}},
+			want:    7 * time.Second,
+		},
+		{
+			name:    "exhausted rate limit waits until reset",
+			attempt: 0,
+			header: func() http.Header {
+				h := http.Header{}
+				h.Set(headerRateLimitRemaining, "0")
+				h.Set(headerRateLimitReset, "1700000006")
+				return h
+			}(),
+			want: 6 * time.Second,
+		},
+		{
+			name:    "server hint capped at max wait",
+			attempt: 0,
+			header:  http.Header{headerRetryAfter: []string{"120"}},
+			want:    10 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := transport.backoff(tt.attempt, &http.Response{Header: tt.header})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResourceAddress(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "axiom_dataset", resourceAddress("axiom_dataset", types.StringUnknown()))
+	assert.Equal(t, `axiom_dataset (id "logs")`, resourceAddress("axiom_dataset", types.StringValue("logs")))
+
+	ctx := withResourceAddress(context.Background(), `axiom_dataset (id "logs")`)
+	assert.Equal(t, `axiom_dataset (id "logs")`, resourceAddressFromContext(ctx))
+	assert.Empty(t, resourceAddressFromContext(context.Background()))
+}
+
+func TestRetryConfigFromModel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults when unset", func(t *testing.T) {
+		t.Parallel()
+
+		retry, diags := retryConfigFromModel(AxiomProviderModel{
+			MaxRetries:   types.Int64Null(),
+			RetryMinWait: types.StringNull(),
+			RetryMaxWait: types.StringNull(),
+		})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, retryConfig{maxRetries: defaultMaxRetries, minWait: defaultRetryMinWait, maxWait: defaultRetryMaxWait}, retry)
+	})
+
+	t.Run("uses configured values", func(t *testing.T) {
+		t.Parallel()
+
+		retry, diags := retryConfigFromModel(AxiomProviderModel{
+			MaxRetries:   types.Int64Value(5),
+			RetryMinWait: types.StringValue("250ms"),
+			RetryMaxWait: types.StringValue("1m"),
+		})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, retryConfig{maxRetries: 5, minWait: 250 * time.Millisecond, maxWait: time.Minute}, retry)
+	})
+
+	t.Run("rejects invalid duration", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := retryConfigFromModel(AxiomProviderModel{RetryMinWait: types.StringValue("soon")})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Duration", diags[0].Summary())
+	})
+
+	t.Run("rejects min wait above max wait", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := retryConfigFromModel(AxiomProviderModel{
+			RetryMinWait: types.StringValue("1m"),
+			RetryMaxWait: types.StringValue("1s"),
+		})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Retry Wait", diags[0].Summary())
+	})
+}
@@ -0,0 +1,140 @@
+package axiom
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	ax "github.com/axiomhq/axiom-go/axiom"
+)
+
+// defaultHTTPClientTimeout matches the timeout of ax.DefaultHTTPClient.
+const defaultHTTPClientTimeout = 5 * time.Minute
+
+// transportConfig holds the provider settings that customise how the provider
+// connects to the Axiom API.
+type transportConfig struct {
+	proxyURL           *url.URL
+	caCertPEM          []byte
+	clientCertPEM      []byte
+	clientKeyPEM       []byte
+	insecureSkipVerify bool
+}
+
+// isDefault reports whether no transport customisation was configured.
+func (c transportConfig) isDefault() bool {
+	return c.proxyURL == nil &&
+		len(c.caCertPEM) == 0 &&
+		len(c.clientCertPEM) == 0 &&
+		len(c.clientKeyPEM) == 0 &&
+		!c.insecureSkipVerify
+}
+
+// transportConfigFromModel resolves the transport settings of the provider
+// block, reading the CA bundle from disk when a file is given.
+func transportConfigFromModel(config AxiomProviderModel) (transportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var transport transportConfig
+
+	if !config.HTTPProxy.IsNull() && config.HTTPProxy.ValueString() != "" {
+		proxyURL, err := url.Parse(config.HTTPProxy.ValueString())
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("Expected an absolute URL such as http://proxy.example.com:3128, got %q.", config.HTTPProxy.ValueString()),
+			)
+		} else {
+			transport.proxyURL = proxyURL
+		}
+	}
+
+	if !config.CACertFile.IsNull() && config.CACertFile.ValueString() != "" {
+		pem, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to read CA certificate file",
+				err.Error(),
+			)
+		} else {
+			transport.caCertPEM = pem
+		}
+	}
+
+	if !config.CACertPEM.IsNull() && config.CACertPEM.ValueString() != "" {
+		transport.caCertPEM = []byte(config.CACertPEM.ValueString())
+	}
+
+	if !config.ClientCert.IsNull() {
+		transport.clientCertPEM = []byte(config.ClientCert.ValueString())
+	}
+
+	if !config.ClientKey.IsNull() {
+		transport.clientKeyPEM = []byte(config.ClientKey.ValueString())
+	}
+
+	transport.insecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+
+	return transport, diags
+}
+
+// newHTTPTransport builds the base transport used for API calls. Without any
+// customisation the axiom-go default transport is used as-is.
+func newHTTPTransport(config transportConfig) (http.RoundTripper, error) {
+	if config.isDefault() {
+		return ax.DefaultHTTPTransport(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+
+	if len(config.caCertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.caCertPEM) {
+			return nil, fmt.Errorf("no valid PEM encoded certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(config.clientCertPEM) > 0 || len(config.clientKeyPEM) > 0 {
+		certificate, err := tls.X509KeyPair(config.clientCertPEM, config.clientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if config.proxyURL != nil {
+		proxy = http.ProxyURL(config.proxyURL)
+	}
+
+	// Mirrors the settings of ax.DefaultHTTPTransport.
+	return &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   time.Second * 30,
+			KeepAlive: time.Second * 30,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		IdleConnTimeout:       time.Minute,
+		ResponseHeaderTimeout: time.Minute * 2,
+		TLSHandshakeTimeout:   time.Second * 10,
+		ExpectContinueTimeout: time.Second * 1,
+		ForceAttemptHTTP2:     true,
+	}, nil
+}
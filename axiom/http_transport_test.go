@@ -0,0 +1,217 @@
+package axiom
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serverCertificatePEM(t *testing.T, server *httptest.Server) []byte {
+	t.Helper()
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+func newTestClientCertificate(t *testing.T) ([]byte, []byte, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-axiom"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return certPEM, keyPEM, cert
+}
+
+func doTestRequest(t *testing.T, config transportConfig, target string) (*http.Response, error) {
+	t.Helper()
+
+	transport, err := newHTTPTransport(config)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: transport, Timeout: 5 * time.Second}
+	return client.Get(target)
+}
+
+func TestNewHTTPTransport_TLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("rejects untrusted server certificate", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := doTestRequest(t, transportConfig{}, server.URL)
+		require.Error(t, err)
+	})
+
+	t.Run("trusts configured CA bundle", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := doTestRequest(t, transportConfig{caCertPEM: serverCertificatePEM(t, server)}, server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("skips verification when insecure", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := doTestRequest(t, transportConfig{insecureSkipVerify: true}, server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("rejects invalid CA bundle", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newHTTPTransport(transportConfig{caCertPEM: []byte("not a certificate")})
+		require.Error(t, err)
+	})
+}
+
+func TestNewHTTPTransport_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM, clientCert := newTestClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "terraform-provider-axiom", r.TLS.PeerCertificates[0].Subject.CommonName)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	t.Run("fails without client certificate", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := doTestRequest(t, transportConfig{caCertPEM: serverCertificatePEM(t, server)}, server.URL)
+		require.Error(t, err)
+	})
+
+	t.Run("presents client certificate", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := doTestRequest(t, transportConfig{
+			caCertPEM:     serverCertificatePEM(t, server),
+			clientCertPEM: certPEM,
+			clientKeyPEM:  keyPEM,
+		}, server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+}
+
+func TestNewHTTPTransport_Proxy(t *testing.T) {
+	t.Parallel()
+
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(proxy.Close)
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+
+	resp, err := doTestRequest(t, transportConfig{proxyURL: proxyURL}, "http://api.axiom.invalid/v2/datasets")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "api.axiom.invalid", proxiedHost)
+}
+
+func TestTransportConfigFromModel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reads CA bundle from file", func(t *testing.T) {
+		t.Parallel()
+
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caFile, []byte("pem"), 0o600))
+
+		transport, diags := transportConfigFromModel(AxiomProviderModel{CACertFile: types.StringValue(caFile)})
+
+		require.False(t, diags.HasError())
+		assert.Equal(t, []byte("pem"), transport.caCertPEM)
+	})
+
+	t.Run("reports missing CA bundle file", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := transportConfigFromModel(AxiomProviderModel{CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unable to read CA certificate file", diags[0].Summary())
+	})
+
+	t.Run("rejects relative proxy URL", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := transportConfigFromModel(AxiomProviderModel{HTTPProxy: types.StringValue("proxy:3128")})
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Invalid Proxy URL", diags[0].Summary())
+	})
+
+	t.Run("defaults without settings", func(t *testing.T) {
+		t.Parallel()
+
+		transport, diags := transportConfigFromModel(AxiomProviderModel{})
+
+		require.False(t, diags.HasError())
+		assert.True(t, transport.isDefault())
+	})
+}
@@ -3,14 +3,21 @@ package axiom
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	ax "github.com/axiomhq/axiom-go/axiom"
@@ -33,13 +40,27 @@ func providerUserAgent() string {
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &axiomProvider{}
+	_ provider.Provider                       = &axiomProvider{}
+	_ provider.ProviderWithEphemeralResources = &axiomProvider{}
 )
 
 // AxiomProviderModel describes the provider data model.
 type AxiomProviderModel struct {
-	ApiToken types.String `tfsdk:"api_token"`
-	BaseUrl  types.String `tfsdk:"base_url"`
+	ApiToken     types.String `tfsdk:"api_token"`
+	BaseUrl      types.String `tfsdk:"base_url"`
+	OrgID        types.String `tfsdk:"org_id"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	ValidateQueries types.Bool `tfsdk:"validate_queries"`
 }
 
 // NewAxiomProvider is a helper function to simplify provider server and testing implementation.
@@ -62,12 +83,70 @@ func (p *axiomProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"api_token": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The Axiom API token.",
+				MarkdownDescription: "The Axiom API token. Either an advanced API token (`xaat-`) or a personal access token (`xapt-`). Personal access tokens also require `org_id`.",
 			},
 			"base_url": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "The base url of the axiom api.",
 			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Axiom organization ID. Required when `api_token` is a personal access token. Can also be set with the `AXIOM_ORG_ID` environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of times a rate limited (429) or failed (5xx) API call is retried. Server errors are only retried for idempotent requests. Set to 0 to disable retries. Defaults to 3, at most 10.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxMaxRetries),
+				},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Initial wait between retries, doubled on every attempt (for example: 500ms, 1s). Defaults to 1s.",
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum wait between retries, also applied to `Retry-After` and rate-limit reset hints from the API (for example: 30s, 1m). Defaults to 30s.",
+			},
+			"http_proxy": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of the proxy used for all API calls (for example: http://proxy.example.com:3128). Defaults to the `HTTPS_PROXY`/`HTTP_PROXY` environment variables.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a PEM encoded CA bundle that is trusted in addition to the system roots, for example for a TLS re-signing proxy.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded CA bundle that is trusted in addition to the system roots. Conflicts with `ca_cert_file`.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "PEM encoded client certificate used for mutual TLS.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM encoded private key of `client_cert`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Skip verification of the API server certificate. Only use this for local development.",
+			},
+			"validate_queries": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Run the APL queries of new and changed monitors against the query API while planning, to check that the datasets and fields exist " +
+					"and that threshold monitors return a numeric column. The queries are run over the last minute and return no rows. Defaults to false.",
+			},
 		},
 	}
 }
@@ -82,6 +161,7 @@ func (p *axiomProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	apiToken := os.Getenv("AXIOM_API_TOKEN")
 	baseUrl := os.Getenv("AXIOM_BASE_URL")
+	orgID := os.Getenv("AXIOM_ORG_ID")
 
 	if !config.ApiToken.IsNull() {
 		apiToken = config.ApiToken.ValueString()
@@ -89,6 +169,9 @@ func (p *axiomProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.BaseUrl.IsNull() {
 		baseUrl = config.BaseUrl.ValueString()
 	}
+	if !config.OrgID.IsNull() {
+		orgID = config.OrgID.ValueString()
+	}
 
 	if apiToken == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -99,14 +182,41 @@ func (p *axiomProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	if !strings.HasPrefix(apiToken, "xaat-") {
-		resp.Diagnostics.AddError("invalid api token", "Please set a valid advanced api token in the provider configuration block.")
+	tokenOption, diags := tokenConfigOption(apiToken, orgID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retry, diags := retryConfigFromModel(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	transport, diags := transportConfigFromModel(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	baseTransport, err := newHTTPTransport(transport)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to configure http transport", err.Error())
+		return
+	}
+
+	httpClient := &http.Client{
+		Transport: newRetryTransport(newLoggingTransport(baseTransport), retry),
+		Timeout:   defaultHTTPClientTimeout,
+	}
+
 	ops := []ax.Option{
-		ax.SetAPITokenConfig(apiToken),
+		tokenOption,
 		ax.SetUserAgent(providerUserAgent()),
+		ax.SetClient(httpClient),
+		// Retries are handled by the transport above.
+		ax.SetNoRetry(),
 	}
 
 	if baseUrl != "" {
@@ -116,10 +226,99 @@ func (p *axiomProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	client, err := ax.NewClient(ops...)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to create axiom client", err.Error())
+		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	data := newProviderData(client)
+	data.validateQueries = config.ValidateQueries.ValueBool()
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+}
+
+// tokenConfigOption returns the client option matching the kind of token that
+// was configured. Personal access tokens are scoped to a user rather than an
+// organization, so they must always come with an organization ID.
+func tokenConfigOption(apiToken, orgID string) (ax.Option, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case strings.HasPrefix(apiToken, "xaat-"):
+		return ax.SetAPITokenConfig(apiToken), diags
+	case strings.HasPrefix(apiToken, "xapt-"):
+		if orgID == "" {
+			diags.AddAttributeError(
+				path.Root("org_id"),
+				"OrgID is required",
+				"Personal access tokens require an organization ID. Please set org_id in the provider configuration block or the AXIOM_ORG_ID environment variable.",
+			)
+			return nil, diags
+		}
+		return ax.SetPersonalTokenConfig(apiToken, orgID), diags
+	default:
+		diags.AddError("invalid api token", "Please set a valid advanced api token or personal access token in the provider configuration block.")
+		return nil, diags
+	}
+}
+
+// retryConfigFromModel resolves the retry settings of the provider block,
+// falling back to the defaults for anything that is not set.
+func retryConfigFromModel(config AxiomProviderModel) (retryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retry := retryConfig{
+		maxRetries: defaultMaxRetries,
+		minWait:    defaultRetryMinWait,
+		maxWait:    defaultRetryMaxWait,
+	}
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retry.maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retry.minWait = parseProviderDuration(&diags, "retry_min_wait", config.RetryMinWait, retry.minWait)
+	retry.maxWait = parseProviderDuration(&diags, "retry_max_wait", config.RetryMaxWait, retry.maxWait)
+	if diags.HasError() {
+		return retryConfig{}, diags
+	}
+
+	if retry.minWait > retry.maxWait {
+		diags.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid Retry Wait",
+			fmt.Sprintf("retry_min_wait (%s) must not be greater than retry_max_wait (%s).", retry.minWait, retry.maxWait),
+		)
+		return retryConfig{}, diags
+	}
+
+	return retry, diags
+}
+
+func parseProviderDuration(diags *diag.Diagnostics, attribute string, value types.String, fallback time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Duration",
+			fmt.Sprintf("Expected a valid Go duration such as 500ms, 1s, or 1m, got %q: %s", value.ValueString(), err),
+		)
+		return 0
+	}
+
+	if duration < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Duration",
+			fmt.Sprintf("%s must be zero or greater.", attribute),
+		)
+		return 0
+	}
+
+	return duration
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -127,6 +326,9 @@ func (p *axiomProvider) DataSources(_ context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		NewDashboardDataSource,
 		NewDatasetDataSource,
+		NewDatasetsDataSource,
+		NewDatasetFieldsDataSource,
+		NewDatasetStatsDataSource,
 		NewMonitorDataSource,
 		NewNotifierDataSource,
 		NewUserDataSource,
@@ -140,10 +342,22 @@ func (p *axiomProvider) Resources(_ context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		NewDashboardResource,
 		NewDatasetResource,
+		NewDatasetFieldResource,
+		NewDatasetMapFieldResource,
+		NewDatasetTrimResource,
+		NewDatasetIngestResource,
 		NewMonitorResource,
+		NewMonitorV2Resource,
 		NewNotifierResource,
 		NewUserResource,
 		NewTokenResource,
 		NewVirtualFieldResource,
 	}
 }
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *axiomProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenEphemeralResource,
+	}
+}
@@ -0,0 +1,138 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// providerData is handed to every resource and data source by
+// axiomProvider.Configure. It holds the API client together with state that is
+// shared for the lifetime of the provider, so that provider-wide settings and
+// caches can be added here without changing how resources are configured.
+type providerData struct {
+	client        *axiom.Client
+	organizations *organizationCache
+	mapFieldLocks *datasetLocks
+	edge          edgeConfig
+	// validateQueries enables running the queries of monitors against the
+	// query API while planning.
+	validateQueries bool
+}
+
+func newProviderData(client *axiom.Client) *providerData {
+	return &providerData{
+		client:        client,
+		organizations: newOrganizationCache(client),
+		mapFieldLocks: newDatasetLocks(),
+		edge:          edgeConfigFromEnvironment(),
+	}
+}
+
+// organizationCache remembers the organizations visible to the configured
+// token. The provider is configured once per Terraform operation, so the
+// metadata is fetched at most once per plan or apply and shared by every
+// resource instead of being requested for each dataset.
+type organizationCache struct {
+	client *axiom.Client
+
+	mu            sync.Mutex
+	organizations []*axiom.Organization
+	loaded        bool
+}
+
+func newOrganizationCache(client *axiom.Client) *organizationCache {
+	return &organizationCache{client: client}
+}
+
+// List returns the cached organizations, fetching them on first use. Failed
+// lookups are not cached so a later call can try again.
+func (c *organizationCache) List(ctx context.Context) ([]*axiom.Organization, error) {
+	if c == nil || c.client == nil {
+		return nil, fmt.Errorf("client is not set")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded {
+		return c.organizations, nil
+	}
+
+	organizations, err := c.client.Organizations.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.organizations = organizations
+	c.loaded = true
+
+	return organizations, nil
+}
+
+// DefaultEdgeDeployment returns the default edge deployment of the
+// organization.
+func (c *organizationCache) DefaultEdgeDeployment(ctx context.Context) (string, error) {
+	organizations, err := c.List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("could not fetch organizations while resolving edge deployment: %w", err)
+	}
+
+	return selectDefaultEdgeDeployment(organizations), nil
+}
+
+// datasetLocks serializes read-modify-write cycles on a dataset. Terraform
+// applies independent resources concurrently, so resources that update the
+// same list of a dataset, like its map fields, must hold the lock of the
+// dataset for the whole cycle to not lose each other's changes.
+type datasetLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newDatasetLocks() *datasetLocks {
+	return &datasetLocks{locks: make(map[string]*sync.Mutex)}
+}
+
+// Lock locks the given dataset and returns the function that unlocks it.
+func (l *datasetLocks) Lock(dataset string) func() {
+	if l == nil {
+		return func() {}
+	}
+
+	l.mu.Lock()
+	lock, ok := l.locks[dataset]
+	if !ok {
+		lock = &sync.Mutex{}
+		l.locks[dataset] = lock
+	}
+	l.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// providerDataFromConfigure extracts the providerData passed to the Configure
+// method of a resource or data source. It returns false when the provider has
+// not been configured yet, which happens during validation, or when the data
+// has an unexpected type, in which case an error is added to diags.
+func providerDataFromConfigure(data any, kind string, diags *diag.Diagnostics) (*providerData, bool) {
+	if data == nil {
+		return nil, false
+	}
+
+	pd, ok := data.(*providerData)
+	if !ok {
+		diags.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", kind),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", data),
+		)
+		return nil, false
+	}
+
+	return pd, true
+}
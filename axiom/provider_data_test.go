@@ -0,0 +1,113 @@
+package axiom
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ax "github.com/axiomhq/axiom-go/axiom"
+)
+
+func TestOrganizationCache(t *testing.T) {
+	t.Run("fetches organizations once", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"id":"org","name":"org","defaultEdgeDeployment":"eu-central-1"}]`))
+		}))
+		t.Cleanup(server.Close)
+
+		cache := newOrganizationCache(newTestClient(t, server.URL))
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				edgeDeployment, err := cache.DefaultEdgeDeployment(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "eu-central-1", edgeDeployment)
+			})
+		}
+		wg.Wait()
+
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("does not cache failures", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"id":"org","name":"org","defaultEdgeDeployment":"us-east-1"}]`))
+		}))
+		t.Cleanup(server.Close)
+
+		cache := newOrganizationCache(newTestClient(t, server.URL))
+
+		_, err := cache.DefaultEdgeDeployment(context.Background())
+		require.Error(t, err)
+
+		edgeDeployment, err := cache.DefaultEdgeDeployment(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "us-east-1", edgeDeployment)
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("without client", func(t *testing.T) {
+		_, err := newOrganizationCache(nil).List(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestProviderDataFromConfigure(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		var diags diag.Diagnostics
+		data, ok := providerDataFromConfigure(nil, "Resource", &diags)
+		assert.False(t, ok)
+		assert.Nil(t, data)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("provider data", func(t *testing.T) {
+		var diags diag.Diagnostics
+		expected := newProviderData(nil)
+		data, ok := providerDataFromConfigure(expected, "Resource", &diags)
+		assert.True(t, ok)
+		assert.Same(t, expected, data)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("unexpected type", func(t *testing.T) {
+		var diags diag.Diagnostics
+		data, ok := providerDataFromConfigure(&ax.Client{}, "Data Source", &diags)
+		assert.False(t, ok)
+		assert.Nil(t, data)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unexpected Data Source Configure Type", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "Expected *providerData, got: *axiom.Client")
+	})
+}
+
+func newTestClient(t *testing.T, url string) *ax.Client {
+	t.Helper()
+
+	client, err := ax.NewClient(
+		ax.SetURL(url),
+		ax.SetAPITokenConfig("xaat-test"),
+		ax.SetNoEnv(),
+		ax.SetNoRetry(),
+	)
+	require.NoError(t, err)
+
+	return client
+}
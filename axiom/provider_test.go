@@ -129,24 +129,28 @@ func TestAccAxiomDataset_kind(t *testing.T) {
 
 					resource "axiom_dataset" "test_otel_metrics" {
 						name = "test-otel-metrics-` + uuid.NewString() + `"
+						deletion_protection = false
 						kind = "otel:metrics:v1"
 						description = "Test OTEL metrics dataset"
 					}
 
 					resource "axiom_dataset" "test_otel_traces" {
 						name = "test-otel-traces-` + uuid.NewString() + `"
+						deletion_protection = false
 						kind = "otel:traces:v1"
 						description = "Test OTEL traces dataset"
 					}
 
 					resource "axiom_dataset" "test_otel_logs" {
 						name = "test-otel-logs-` + uuid.NewString() + `"
+						deletion_protection = false
 						kind = "otel:logs:v1"
 						description = "Test OTEL logs dataset"
 					}
 
 					resource "axiom_dataset" "test_default_kind" {
 						name = "test-default-kind-` + uuid.NewString() + `"
+						deletion_protection = false
 						description = "Test dataset with default kind"
 					}
 				`,
@@ -191,6 +195,7 @@ func TestAccAxiomResources_resolvable(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "new-dataset"
+						deletion_protection = false
 						description = "A test dataset"
 					}
 
@@ -245,6 +250,7 @@ func TestAccAxiomResources_recreate_after_api_deletion(t *testing.T) {
 
 		resource "axiom_dataset" "test" {
 			name        = "` + datasetName + `"
+			deletion_protection = false
 			description = "A test dataset for recreate test"
 		}
 
@@ -420,6 +426,7 @@ func TestAccAxiomResources_dataset_retention(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "new-dataset"
+						deletion_protection = false
 						description = "A test dataset"
 					}
 				`,
@@ -440,6 +447,7 @@ func TestAccAxiomResources_dataset_retention(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "new-dataset"
+						deletion_protection = false
 						use_retention_period = true
 						retention_days = 30
 					}
@@ -461,6 +469,7 @@ func TestAccAxiomResources_dataset_retention(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "new-dataset"
+						deletion_protection = false
 						description = "Updated description for a test dataset to check retention period settings"
 					}
 				`,
@@ -481,6 +490,7 @@ func TestAccAxiomResources_dataset_retention(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "new-dataset"
+						deletion_protection = false
 						retention_days = 0
 					}
 				`,
@@ -513,6 +523,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						description = "A test dataset"
 						map_fields = ["field1", "field2"]
 					}
@@ -535,6 +546,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						map_fields = ["field1", "field2", "field3", "field4"]
 					}
 				`,
@@ -557,6 +569,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						description = "Updated description for a test dataset to check map-fields"
 					}
 				`,
@@ -580,6 +593,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						map_fields = [""]
 					}
 				`,
@@ -595,6 +609,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						map_fields = null
 					}
 				`,
@@ -617,6 +632,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						map_fields = [" xx "]
 					}
 				`,
@@ -632,6 +648,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						map_fields = ["dupe", "dupe"]
 					}
 				`,
@@ -647,6 +664,7 @@ func TestAccAxiomResources_dataset_map_fields(t *testing.T) {
 
 					resource "axiom_dataset" "test" {
 						name        = "` + datasetName + `"
+						deletion_protection = false
 						map_fields = []
 					}
 				`,
@@ -679,24 +697,28 @@ func TestAccAxiomResources_dataset_map_kind(t *testing.T) {
 
 					resource "axiom_dataset" "test_otel_metrics" {
 						name = "test-otel-metrics-` + uuid.NewString() + `"
+						deletion_protection = false
 						kind = "otel:metrics:v1"
 						description = "Test OTEL metrics dataset"
 					}
 
 					resource "axiom_dataset" "test_otel_traces" {
 						name = "test-otel-traces-` + uuid.NewString() + `"
+						deletion_protection = false
 						kind = "otel:traces:v1"
 						description = "Test OTEL traces dataset"
 					}
 
 					resource "axiom_dataset" "test_otel_logs" {
 						name = "test-otel-logs-` + uuid.NewString() + `"
+						deletion_protection = false
 						kind = "otel:logs:v1"
 						description = "Test OTEL logs dataset"
 					}
 
 					resource "axiom_dataset" "test_default_kind" {
 						name = "test-default-kind-` + uuid.NewString() + `"
+						deletion_protection = false
 						description = "Test dataset with default kind"
 					}
 				`,
@@ -879,6 +901,7 @@ provider "axiom" {
 
 resource "axiom_dataset" "test" {
   name        = "terraform-provider-dataset"
+  deletion_protection = false
   description = "A test dataset"
 }
 
@@ -892,6 +915,7 @@ resource "axiom_virtual_field" "test" {
 
 resource "axiom_dataset" "test_without_description" {
   name = "terraform-provider-dataset-without-description"
+  deletion_protection = false
 }
 
 resource "axiom_notifier" "slack_test" {
@@ -0,0 +1,48 @@
+package axiom
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenConfigOption(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepts advanced api token without org id", func(t *testing.T) {
+		t.Parallel()
+
+		option, diags := tokenConfigOption("xaat-123", "")
+
+		require.False(t, diags.HasError())
+		assert.NotNil(t, option)
+	})
+
+	t.Run("accepts personal access token with org id", func(t *testing.T) {
+		t.Parallel()
+
+		option, diags := tokenConfigOption("xapt-123", "my-org")
+
+		require.False(t, diags.HasError())
+		assert.NotNil(t, option)
+	})
+
+	t.Run("rejects personal access token without org id", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := tokenConfigOption("xapt-123", "")
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "AXIOM_ORG_ID")
+	})
+
+	t.Run("rejects unknown token prefix", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := tokenConfigOption("abc-123", "my-org")
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "invalid api token", diags[0].Summary())
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -50,6 +51,13 @@ type DashboardResourceModel struct {
 	Overwrite types.Bool   `tfsdk:"overwrite"`
 }
 
+// dashboardResourceModelWithTimeouts adds the timeouts block, which only the
+// managed resource exposes, to the dashboard model.
+type dashboardResourceModelWithTimeouts struct {
+	DashboardResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type dashboardUpsertRequest struct {
 	Dashboard json.RawMessage `json:"dashboard"`
 	UID       string          `json:"uid,omitempty"`
@@ -76,7 +84,7 @@ func (r *DashboardResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_dashboard"
 }
 
-func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DashboardResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -106,39 +114,40 @@ func (r *DashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "When `true`, force update and ignore `version` conflicts.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
 func (r *DashboardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*axiom.Client)
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *DashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan DashboardResourceModel
+	var plan dashboardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "dashboard", resourceAddress("axiom_dashboard", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	payload, uid, diags := dashboardUpsertPayloadFromModel(plan, "", 0, true)
+	payload, uid, diags := dashboardUpsertPayloadFromModel(plan.DashboardResourceModel, "", 0, true)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -168,22 +177,31 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, dashboardResourceModelWithTimeouts{
+		DashboardResourceModel: state,
+		Timeouts:               plan.Timeouts,
+	})...)
 }
 
 func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state DashboardResourceModel
+	var state dashboardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, state.Timeouts, operationRead, "dashboard", resourceAddress("axiom_dashboard", state.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	uid := dashboardUIDFromState(state)
+	uid := dashboardUIDFromState(state.DashboardResourceModel)
 	rawResp, err := r.client.Dashboards.GetRaw(ctx, uid)
 	if err != nil {
 		if isNotFoundError(err) {
@@ -211,12 +229,15 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattened)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, dashboardResourceModelWithTimeouts{
+		DashboardResourceModel: flattened,
+		Timeouts:               state.Timeouts,
+	})...)
 }
 
 func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan DashboardResourceModel
-	var state DashboardResourceModel
+	var plan dashboardResourceModelWithTimeouts
+	var state dashboardResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -224,12 +245,18 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "dashboard", resourceAddress("axiom_dashboard", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	uidFromState := dashboardUIDFromState(state)
+	uidFromState := dashboardUIDFromState(state.DashboardResourceModel)
 	stateVersion := int64(0)
 	if !plan.Overwrite.IsNull() && !plan.Overwrite.IsUnknown() && !plan.Overwrite.ValueBool() {
 		remote, err := r.client.Dashboards.GetRaw(ctx, uidFromState)
@@ -255,7 +282,7 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 
 		stateVersion = remoteDashboard.Version
 	}
-	payload, uid, diags := dashboardUpsertPayloadFromModel(plan, uidFromState, stateVersion, false)
+	payload, uid, diags := dashboardUpsertPayloadFromModel(plan.DashboardResourceModel, uidFromState, stateVersion, false)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -285,22 +312,31 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattened)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, dashboardResourceModelWithTimeouts{
+		DashboardResourceModel: flattened,
+		Timeouts:               plan.Timeouts,
+	})...)
 }
 
 func (r *DashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state DashboardResourceModel
+	var state dashboardResourceModelWithTimeouts
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, state.Timeouts, operationDelete, "dashboard", resourceAddress("axiom_dashboard", state.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	uid := dashboardUIDFromState(state)
+	uid := dashboardUIDFromState(state.DashboardResourceModel)
 	if err := r.client.Dashboards.Delete(ctx, uid); err != nil {
 		if isNotFoundError(err) {
 			return
@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,16 +24,28 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/axiomhq/axiom-go/axiom"
 )
 
 var validMapFieldNameRe = regexp.MustCompile("^[a-zA-Z0-9]+([a-zA-Z0-9_.-]*[a-zA-Z0-9]+)?$")
 
+// datasetKinds are the kinds a dataset can be created with.
+var datasetKinds = []string{
+	"axiom:events:v1",
+	"otel:metrics:v1",
+	"otel:traces:v1",
+	"otel:logs:v1",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DatasetResource{}
-	_ resource.ResourceWithImportState = &DatasetResource{}
+	_ resource.Resource                   = &DatasetResource{}
+	_ resource.ResourceWithImportState    = &DatasetResource{}
+	_ resource.ResourceWithUpgradeState   = &DatasetResource{}
+	_ resource.ResourceWithModifyPlan     = &DatasetResource{}
+	_ resource.ResourceWithValidateConfig = &DatasetResource{}
 )
 
 func NewDatasetResource() resource.Resource {
@@ -36,7 +54,9 @@ func NewDatasetResource() resource.Resource {
 
 // DatasetResource defines the resource implementation.
 type DatasetResource struct {
-	client *axiom.Client
+	client        *axiom.Client
+	organizations *organizationCache
+	mapFieldLocks *datasetLocks
 }
 
 // DatasetResourceModel describes the resource data model.
@@ -51,11 +71,25 @@ type DatasetResourceModel struct {
 	MapFields          types.List   `tfsdk:"map_fields"`
 }
 
+// datasetResourceState is the state of the managed dataset resource. It adds
+// the deletion protection, the retention reduction confirmation and the
+// timeouts block to the dataset model shared with the data sources.
+type datasetResourceState struct {
+	DatasetResourceModel
+	DeletionProtection        types.Bool     `tfsdk:"deletion_protection"`
+	ConfirmRetentionReduction types.Bool     `tfsdk:"confirm_retention_reduction"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// datasetManagedAttributes are the attributes of the dataset resource that
+// only apply to managed datasets and are left out of the data sources.
+var datasetManagedAttributes = []string{"deletion_protection", "confirm_retention_reduction"}
+
 func (r *DatasetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dataset"
 }
 
-func (r *DatasetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DatasetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
@@ -75,12 +109,7 @@ func (r *DatasetResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"axiom:events:v1",
-						"otel:metrics:v1",
-						"otel:traces:v1",
-						"otel:logs:v1",
-					),
+					stringvalidator.OneOf(datasetKinds...),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -117,7 +146,7 @@ func (r *DatasetResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"retention_days": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "Retention days for the dataset",
+				MarkdownDescription: "Retention days for the dataset. Must be greater than 0 when use_retention_period is true. The API doesn't report the longest retention the plan of the organization allows, so that limit is only enforced by the API when applying",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -139,60 +168,78 @@ func (r *DatasetResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether Terraform is prevented from destroying or replacing the dataset, which permanently deletes its data. Must be set to `false` in a separate apply before the dataset can be destroyed or replaced. Defaults to `true`",
+			},
+			"confirm_retention_reduction": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether reducing the retention of the dataset, which permanently deletes data older than the new retention, is confirmed. Reductions are always planned with a warning, fail to plan if this is set to `false` and are confirmed if it is set to `true`",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
 
 func (r *DatasetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*axiom.Client)
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.organizations = data.organizations
+	r.mapFieldLocks = data.mapFieldLocks
 }
 
 func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan DatasetResourceModel
+	var plan datasetResourceState
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if r.client == nil {
-		resp.Diagnostics.AddError("Client Error", "Client is not set")
+	// Retention days that were unknown while planning are only known now.
+	resp.Diagnostics.Append(validateDatasetRetention(plan.DatasetResourceModel)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if plan.UseRetentionPeriod.ValueBool() && plan.RetentionDays.ValueInt64() == 0 {
-		resp.Diagnostics.AddError("Client Error", "Retention days must be greater than 0 when use_retention_period is true")
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "dataset", resourceAddress("axiom_dataset", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	ds, err := r.client.Datasets.Create(ctx, datasetCreateRequestFromPlan(plan))
+	ds, err := r.client.Datasets.Create(ctx, datasetCreateRequestFromPlan(plan.DatasetResourceModel))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dataset, got error: %s", err))
 		return
 	}
 
-	state, err := flattenDatasetWithOrgDefault(ctx, r.client, ds)
+	state, err := flattenDatasetWithOrgDefault(ctx, r.organizations, ds)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
 		state = flattenDataset(ds, "")
 	}
 
 	// Set state immediately after creation to avoid orphaned resources
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, datasetResourceState{
+		DatasetResourceModel:      state,
+		DeletionProtection:        plan.DeletionProtection,
+		ConfirmRetentionReduction: plan.ConfirmRetentionReduction,
+		Timeouts:                  plan.Timeouts,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -205,7 +252,9 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
+		unlock := r.mapFieldLocks.Lock(ds.ID)
 		resMapFields, err := r.client.Datasets.UpdateMapFields(ctx, ds.ID, mapFields)
+		unlock()
 		if err != nil {
 			resp.Diagnostics.AddError("failed to update dataset map-fields", err.Error())
 			return
@@ -213,24 +262,35 @@ func (r *DatasetResource) Create(ctx context.Context, req resource.CreateRequest
 
 		ds.MapFields = resMapFields
 
-		state, err := flattenDatasetWithOrgDefault(ctx, r.client, ds)
+		state, err := flattenDatasetWithOrgDefault(ctx, r.organizations, ds)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
 			state = flattenDataset(ds, "")
 		}
 
-		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, datasetResourceState{
+			DatasetResourceModel:      state,
+			DeletionProtection:        plan.DeletionProtection,
+			ConfirmRetentionReduction: plan.ConfirmRetentionReduction,
+			Timeouts:                  plan.Timeouts,
+		})...)
 	}
 }
 
 func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan DatasetResourceModel
+	var plan datasetResourceState
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "dataset", resourceAddress("axiom_dataset", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ds, err := r.client.Datasets.Get(ctx, plan.ID.ValueString())
 	if err != nil {
 		if isNotFoundError(err) {
@@ -245,30 +305,36 @@ func (r *DatasetResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	state, err := flattenDatasetWithOrgDefault(ctx, r.client, ds)
+	state, err := flattenDatasetWithOrgDefault(ctx, r.organizations, ds)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
 		state = flattenDataset(ds, "")
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, datasetResourceState{
+		DatasetResourceModel:      state,
+		DeletionProtection:        plan.DeletionProtection,
+		ConfirmRetentionReduction: plan.ConfirmRetentionReduction,
+		Timeouts:                  plan.Timeouts,
+	})...)
 }
 
 func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan DatasetResourceModel
+	var plan datasetResourceState
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if r.client == nil {
-		resp.Diagnostics.AddError("Client Error", "Client is not set")
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "dataset", resourceAddress("axiom_dataset", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if plan.UseRetentionPeriod.ValueBool() && plan.RetentionDays.ValueInt64() == 0 {
-		resp.Diagnostics.AddError("Client Error", "Retention days must be greater than 0 when use_retention_period is true")
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
@@ -282,14 +348,19 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	state, err := flattenDatasetWithOrgDefault(ctx, r.client, ds)
+	state, err := flattenDatasetWithOrgDefault(ctx, r.organizations, ds)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
 		state = flattenDataset(ds, "")
 	}
 
 	// Set state immediately after update to preserve changes
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, datasetResourceState{
+		DatasetResourceModel:      state,
+		DeletionProtection:        plan.DeletionProtection,
+		ConfirmRetentionReduction: plan.ConfirmRetentionReduction,
+		Timeouts:                  plan.Timeouts,
+	})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -302,7 +373,9 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 			return
 		}
 
+		unlock := r.mapFieldLocks.Lock(plan.ID.ValueString())
 		resMapFields, err := r.client.Datasets.UpdateMapFields(ctx, plan.ID.ValueString(), mapFields)
+		unlock()
 		if err != nil {
 			resp.Diagnostics.AddError("failed to update dataset map-fields", err.Error())
 			return
@@ -310,32 +383,333 @@ func (r *DatasetResource) Update(ctx context.Context, req resource.UpdateRequest
 
 		ds.MapFields = resMapFields
 
-		state, err := flattenDatasetWithOrgDefault(ctx, r.client, ds)
+		state, err := flattenDatasetWithOrgDefault(ctx, r.organizations, ds)
 		if err != nil {
 			resp.Diagnostics.AddWarning("Unable to resolve default edge deployment", err.Error())
 			state = flattenDataset(ds, "")
 		}
 
-		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, datasetResourceState{
+			DatasetResourceModel:      state,
+			DeletionProtection:        plan.DeletionProtection,
+			ConfirmRetentionReduction: plan.ConfirmRetentionReduction,
+			Timeouts:                  plan.Timeouts,
+		})...)
 	}
 }
 
 func (r *DatasetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var plan DatasetResourceModel
+	var plan datasetResourceState
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Dataset Deletion Protected",
+			fmt.Sprintf("Dataset %s has deletion_protection enabled. Deleting a dataset permanently deletes its data, "+
+				"so set deletion_protection = false and apply that change before destroying the dataset.", plan.Name.ValueString()),
+		)
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "dataset", resourceAddress("axiom_dataset", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Datasets.Delete(ctx, plan.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete dataset", err.Error())
 		return
 	}
 }
 
+func (r *DatasetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config datasetResourceState
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDatasetRetention(config.DatasetResourceModel)...)
+}
+
+func (r *DatasetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan datasetResourceState
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration alone doesn't tell whether retention is enabled on
+	// existing datasets, so the planned values are validated again.
+	resp.Diagnostics.Append(validateDatasetRetention(plan.DatasetResourceModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state datasetResourceState
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replacing a dataset deletes it first, so deletion protection has to be
+	// disabled in a separate apply, before the replacement is planned.
+	if replaced := datasetReplacedAttributes(state.DatasetResourceModel, plan.DatasetResourceModel); len(replaced) > 0 && state.DeletionProtection.ValueBool() {
+		for _, attribute := range replaced {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Dataset Deletion Protected",
+				fmt.Sprintf("Changing %s replaces dataset %s, which permanently deletes its data, but deletion_protection is enabled. "+
+					"Set deletion_protection = false and apply that change before changing %s.", attribute, state.Name.ValueString(), attribute),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkRetentionReduction(ctx, state.DatasetResourceModel, plan)...)
+
+	// map_fields is authoritative, so map fields attached by
+	// axiom_dataset_map_field resources are removed when it is set.
+	removed := removedMapFields(state.MapFields, plan.MapFields)
+	if len(removed) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("map_fields"),
+			"Map Fields Will Be Removed",
+			fmt.Sprintf("map_fields is authoritative, so the map fields %s of dataset %s that are not in the configuration will be removed. "+
+				"If they are managed by axiom_dataset_map_field resources, leave map_fields unset on this dataset and manage all of its map fields with axiom_dataset_map_field.",
+				strings.Join(removed, ", "), plan.Name.ValueString()),
+		)
+	}
+}
+
+// validateDatasetRetention checks that the retention is enabled with a positive
+// number of days. Unknown days are left to the apply, as they either come from
+// other resources or are kept from the state of existing datasets.
+func validateDatasetRetention(model DatasetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	days := model.RetentionDays
+	if !days.IsNull() && !days.IsUnknown() && days.ValueInt64() < 0 {
+		diags.AddAttributeError(
+			path.Root("retention_days"),
+			"Invalid Retention",
+			fmt.Sprintf("Retention days must not be negative, got %d.", days.ValueInt64()),
+		)
+		return diags
+	}
+
+	if !model.UseRetentionPeriod.ValueBool() {
+		return diags
+	}
+
+	if days.IsUnknown() {
+		return diags
+	}
+
+	if days.ValueInt64() == 0 {
+		diags.AddAttributeError(
+			path.Root("retention_days"),
+			"Invalid Retention",
+			"Retention days must be greater than 0 when use_retention_period is true",
+		)
+	}
+
+	return diags
+}
+
+// checkRetentionReduction warns about plans that reduce the retention of the
+// dataset, which deletes older data, and adds an error when
+// confirm_retention_reduction is set to false.
+func (r *DatasetResource) checkRetentionReduction(ctx context.Context, state DatasetResourceModel, plan datasetResourceState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldDays, newDays, reduced := retentionReduction(state, plan.DatasetResourceModel)
+	if !reduced {
+		return diags
+	}
+
+	oldRetention := "unlimited"
+	if oldDays > 0 {
+		oldRetention = fmt.Sprintf("%d days", oldDays)
+	}
+
+	detail := fmt.Sprintf("Reducing the retention of dataset %s from %s to %d days permanently deletes its events older than %d days.",
+		state.Name.ValueString(), oldRetention, newDays, newDays)
+
+	if r.client != nil {
+		if stats, err := listDatasetStats(ctx, r.client); err != nil {
+			tflog.Debug(ctx, "unable to estimate the data deleted by the retention reduction", map[string]any{"error": err.Error()})
+		} else if i := slices.IndexFunc(stats, func(s datasetStats) bool { return s.Name == state.Name.ValueString() }); i >= 0 {
+			events, bytes := estimateRetentionLoss(stats[i], newDays, time.Now())
+			detail += fmt.Sprintf(" Based on the current usage of the dataset, approximately %d events (%d compressed bytes) are affected.", events, bytes)
+		}
+	}
+
+	diags.AddAttributeWarning(path.Root("retention_days"), "Retention Reduction Deletes Data", detail)
+
+	if !plan.ConfirmRetentionReduction.IsNull() && !plan.ConfirmRetentionReduction.IsUnknown() && !plan.ConfirmRetentionReduction.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("confirm_retention_reduction"),
+			"Retention Reduction Not Confirmed",
+			detail+" Set confirm_retention_reduction = true to confirm the reduction.",
+		)
+	}
+
+	return diags
+}
+
+// retentionReduction returns the retention in days before and after the plan,
+// where zero days mean unlimited retention, and whether the plan reduces it.
+func retentionReduction(state, plan DatasetResourceModel) (int64, int64, bool) {
+	oldDays := int64(0)
+	if state.UseRetentionPeriod.ValueBool() {
+		oldDays = state.RetentionDays.ValueInt64()
+	}
+
+	if plan.UseRetentionPeriod.IsUnknown() || plan.RetentionDays.IsUnknown() || !plan.UseRetentionPeriod.ValueBool() {
+		return oldDays, 0, false
+	}
+
+	newDays := plan.RetentionDays.ValueInt64()
+	reduced := newDays > 0 && (oldDays == 0 || newDays < oldDays)
+
+	return oldDays, newDays, reduced
+}
+
+// estimateRetentionLoss approximates the events and compressed bytes of a
+// dataset that are older than the given retention, assuming the events are
+// spread evenly between the oldest and the newest event.
+func estimateRetentionLoss(stats datasetStats, days int64, now time.Time) (uint64, uint64) {
+	cutoff := now.Add(-time.Duration(days) * 24 * time.Hour)
+	if stats.MinTime.IsZero() || !stats.MinTime.Before(cutoff) {
+		return 0, 0
+	}
+
+	if !stats.MaxTime.After(stats.MinTime) || !stats.MaxTime.After(cutoff) {
+		return stats.NumEvents, stats.CompressedBytes
+	}
+
+	share := float64(cutoff.Sub(stats.MinTime)) / float64(stats.MaxTime.Sub(stats.MinTime))
+
+	return uint64(share * float64(stats.NumEvents)), uint64(share * float64(stats.CompressedBytes))
+}
+
+// datasetReplacedAttributes returns the attributes that require the dataset to
+// be replaced and whose planned value differs from the state.
+func datasetReplacedAttributes(state, plan DatasetResourceModel) []string {
+	var replaced []string
+	if !plan.Name.Equal(state.Name) {
+		replaced = append(replaced, "name")
+	}
+	if !plan.Kind.Equal(state.Kind) {
+		replaced = append(replaced, "kind")
+	}
+
+	return replaced
+}
+
+// removedMapFields returns the map fields in the state that are not in the
+// plan. Nothing is removed when the plan doesn't configure the map fields.
+func removedMapFields(state, plan types.List) []string {
+	if state.IsNull() || state.IsUnknown() || plan.IsNull() || plan.IsUnknown() {
+		return nil
+	}
+
+	planned := make(map[string]bool, len(plan.Elements()))
+	for _, element := range plan.Elements() {
+		if value, ok := element.(types.String); ok {
+			planned[value.ValueString()] = true
+		}
+	}
+
+	var removed []string
+	for _, element := range state.Elements() {
+		if value, ok := element.(types.String); ok && !planned[value.ValueString()] {
+			removed = append(removed, value.ValueString())
+		}
+	}
+
+	return removed
+}
+
 func (r *DatasetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Imported datasets are protected until the configuration says otherwise.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+}
+
+// datasetResourceModelV0 describes the version 0 state of a dataset, written
+// before kinds, edge deployments, retention and map fields were supported.
+type datasetResourceModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *DatasetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			StateUpgrader: upgradeDatasetStateV0,
+		},
+	}
+}
+
+// upgradeDatasetStateV0 upgrades the state to version 1. Attributes that were
+// added since are left null so they are refreshed from the API on the next
+// read, except for the kind which was always the default events kind and the
+// deletion protection, which takes its default so the dataset is protected
+// right away.
+func upgradeDatasetStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior datasetResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, datasetResourceState{
+		DatasetResourceModel: DatasetResourceModel{
+			ID:                 prior.ID,
+			Name:               prior.Name,
+			Kind:               types.StringValue("axiom:events:v1"),
+			Description:        prior.Description,
+			EdgeDeployment:     types.StringNull(),
+			UseRetentionPeriod: types.BoolNull(),
+			RetentionDays:      types.Int64Null(),
+			MapFields:          types.ListNull(types.StringType),
+		},
+		DeletionProtection:        types.BoolValue(true),
+		ConfirmRetentionReduction: types.BoolNull(),
+		Timeouts:                  nullTimeouts(),
+	})...)
 }
 
 func flattenDataset(dataset *axiom.Dataset, defaultEdgeDeployment string) DatasetResourceModel {
@@ -388,12 +762,12 @@ func edgeDeploymentValue(edgeDeployment types.String) string {
 	return edgeDeployment.ValueString()
 }
 
-func flattenDatasetWithOrgDefault(ctx context.Context, client *axiom.Client, dataset *axiom.Dataset) (DatasetResourceModel, error) {
+func flattenDatasetWithOrgDefault(ctx context.Context, organizations *organizationCache, dataset *axiom.Dataset) (DatasetResourceModel, error) {
 	if dataset.EdgeDeployment != "" {
 		return flattenDataset(dataset, ""), nil
 	}
 
-	defaultEdgeDeployment, err := fetchDefaultEdgeDeployment(ctx, client)
+	defaultEdgeDeployment, err := organizations.DefaultEdgeDeployment(ctx)
 	if err != nil {
 		return DatasetResourceModel{}, err
 	}
@@ -401,19 +775,6 @@ func flattenDatasetWithOrgDefault(ctx context.Context, client *axiom.Client, dat
 	return flattenDataset(dataset, defaultEdgeDeployment), nil
 }
 
-func fetchDefaultEdgeDeployment(ctx context.Context, client *axiom.Client) (string, error) {
-	if client == nil {
-		return "", fmt.Errorf("client is not set")
-	}
-
-	organizations, err := client.Organizations.List(ctx)
-	if err != nil {
-		return "", fmt.Errorf("could not fetch organizations while resolving edge deployment: %w", err)
-	}
-
-	return selectDefaultEdgeDeployment(organizations), nil
-}
-
 func selectDefaultEdgeDeployment(organizations []*axiom.Organization) string {
 	for _, organization := range organizations {
 		if organization == nil || organization.DefaultEdgeDeployment == "" {
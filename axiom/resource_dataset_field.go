@@ -0,0 +1,282 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DatasetFieldResource{}
+	_ resource.ResourceWithImportState = &DatasetFieldResource{}
+)
+
+func NewDatasetFieldResource() resource.Resource {
+	return &DatasetFieldResource{}
+}
+
+// DatasetFieldResource defines the resource implementation.
+type DatasetFieldResource struct {
+	client *axiom.Client
+}
+
+// DatasetFieldResourceModel describes the resource data model.
+type DatasetFieldResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Dataset     types.String   `tfsdk:"dataset"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Unit        types.String   `tfsdk:"unit"`
+	Hidden      types.Bool     `tfsdk:"hidden"`
+	Type        types.String   `tfsdk:"type"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatasetFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_field"
+}
+
+func (r *DatasetFieldResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the metadata of a single dataset field. The field itself is created by ingesting " +
+			"data into the dataset; destroying the resource resets the metadata of the field.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Dataset field identifier in the format `dataset/field`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Dataset the field belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the field",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the field",
+			},
+			"unit": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Unit of the field (for example, 'ms' or 'bytes')",
+			},
+			"hidden": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the field is hidden",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the field as detected by Axiom",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *DatasetFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *DatasetFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DatasetFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "dataset field", resourceAddress("axiom_dataset_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	field, err := updateDatasetField(ctx, r.client, plan.Dataset.ValueString(), plan.Name.ValueString(), datasetFieldUpdateRequestFromPlan(plan))
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Dataset Field Not Found",
+				fmt.Sprintf("Field %s does not exist in dataset %s. Fields are created by ingesting data that contains them.", plan.Name.ValueString(), plan.Dataset.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dataset field, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, flattenDatasetField(plan.Dataset.ValueString(), field, plan.Timeouts))...)
+}
+
+func (r *DatasetFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var plan DatasetFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "dataset field", resourceAddress("axiom_dataset_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := getDatasetField(ctx, r.client, plan.Dataset.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Dataset Field Not Found",
+				fmt.Sprintf("Field %s of dataset %s does not exist and will be recreated if still defined in the configuration.", plan.Name.ValueString(), plan.Dataset.ValueString()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read dataset field", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, flattenDatasetField(plan.Dataset.ValueString(), field, plan.Timeouts))...)
+}
+
+func (r *DatasetFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatasetFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "dataset field", resourceAddress("axiom_dataset_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := updateDatasetField(ctx, r.client, plan.Dataset.ValueString(), plan.Name.ValueString(), datasetFieldUpdateRequestFromPlan(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update dataset field", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, flattenDatasetField(plan.Dataset.ValueString(), field, plan.Timeouts))...)
+}
+
+func (r *DatasetFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan DatasetFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "dataset field", resourceAddress("axiom_dataset_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fields can't be deleted, so only their metadata is reset.
+	_, err := updateDatasetField(ctx, r.client, plan.Dataset.ValueString(), plan.Name.ValueString(), datasetFieldUpdateRequest{})
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to reset dataset field", err.Error())
+		return
+	}
+}
+
+func (r *DatasetFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	dataset, name, diags := parseDatasetScopedID(req.ID, "field")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset"), dataset)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// parseDatasetScopedID splits an ID in the format dataset/name, where kind
+// names what the name refers to. Dataset names can't contain slashes, so
+// everything after the first one is the name.
+func parseDatasetScopedID(id, kind string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dataset, name, ok := strings.Cut(id, "/")
+	if !ok || dataset == "" || name == "" {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the format dataset/%s, got: %q", kind, id),
+		)
+	}
+
+	return dataset, name, diags
+}
+
+func datasetFieldUpdateRequestFromPlan(plan DatasetFieldResourceModel) datasetFieldUpdateRequest {
+	return datasetFieldUpdateRequest{
+		Description: plan.Description.ValueString(),
+		Unit:        plan.Unit.ValueString(),
+		Hidden:      plan.Hidden.ValueBool(),
+	}
+}
+
+func flattenDatasetField(dataset string, field *datasetField, timeoutsValue timeouts.Value) DatasetFieldResourceModel {
+	return DatasetFieldResourceModel{
+		ID:          types.StringValue(dataset + "/" + field.Name),
+		Dataset:     types.StringValue(dataset),
+		Name:        types.StringValue(field.Name),
+		Description: stringOrNull(field.Description),
+		Unit:        stringOrNull(field.Unit),
+		Hidden:      types.BoolValue(field.Hidden),
+		Type:        stringOrNull(field.Type),
+		Timeouts:    timeoutsValue,
+	}
+}
@@ -0,0 +1,93 @@
+package axiom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDatasetScopedID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          string
+		wantDataset string
+		wantName    string
+		wantErr     bool
+	}{
+		{id: "logs/status", wantDataset: "logs", wantName: "status"},
+		{id: "logs/attributes.http/route", wantDataset: "logs", wantName: "attributes.http/route"},
+		{id: "logs", wantErr: true},
+		{id: "logs/", wantErr: true},
+		{id: "/status", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			dataset, name, diags := parseDatasetScopedID(tt.id, "field")
+			if tt.wantErr {
+				require.True(t, diags.HasError())
+				assert.Equal(t, "Invalid Import ID", diags[0].Summary())
+				assert.Contains(t, diags[0].Detail(), "dataset/field")
+				return
+			}
+
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.wantDataset, dataset)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestUpdateDatasetField(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v2/datasets/logs/fields/duration", r.URL.Path)
+
+		var req datasetFieldUpdateRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, datasetFieldUpdateRequest{Description: "Request duration", Unit: "ms", Hidden: true}, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"duration","type":"float","unit":"ms","description":"Request duration","hidden":true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	plan := DatasetFieldResourceModel{
+		Dataset:     types.StringValue("logs"),
+		Name:        types.StringValue("duration"),
+		Description: types.StringValue("Request duration"),
+		Unit:        types.StringValue("ms"),
+		Hidden:      types.BoolValue(true),
+	}
+
+	field, err := updateDatasetField(context.Background(), newTestClient(t, srv.URL), "logs", "duration", datasetFieldUpdateRequestFromPlan(plan))
+	require.NoError(t, err)
+
+	state := flattenDatasetField("logs", field, nullTimeouts())
+	assert.Equal(t, "logs/duration", state.ID.ValueString())
+	assert.Equal(t, "float", state.Type.ValueString())
+	assert.Equal(t, plan.Description, state.Description)
+	assert.Equal(t, plan.Unit, state.Unit)
+	assert.Equal(t, plan.Hidden, state.Hidden)
+}
+
+func TestFlattenDatasetField_EmptyMetadata(t *testing.T) {
+	t.Parallel()
+
+	state := flattenDatasetField("logs", &datasetField{Name: "status", Type: "integer"}, nullTimeouts())
+
+	assert.True(t, state.Description.IsNull())
+	assert.True(t, state.Unit.IsNull())
+	assert.False(t, state.Hidden.ValueBool())
+}
@@ -0,0 +1,279 @@
+package axiom
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/axiomhq/axiom-go/axiom"
+	"github.com/axiomhq/axiom-go/axiom/ingest"
+)
+
+const (
+	ingestFormatJSON   = "json"
+	ingestFormatNDJSON = "ndjson"
+
+	// maxReportedIngestFailures limits how many failed events are listed in the
+	// warning about failed events.
+	maxReportedIngestFailures = 5
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DatasetIngestResource{}
+
+func NewDatasetIngestResource() resource.Resource {
+	return &DatasetIngestResource{}
+}
+
+// DatasetIngestResource defines the resource implementation.
+type DatasetIngestResource struct {
+	client *axiom.Client
+}
+
+// DatasetIngestResourceModel describes the resource data model.
+type DatasetIngestResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Dataset        types.String   `tfsdk:"dataset"`
+	Content        types.String   `tfsdk:"content"`
+	File           types.String   `tfsdk:"file"`
+	ContentHash    types.String   `tfsdk:"content_hash"`
+	Format         types.String   `tfsdk:"format"`
+	Ingested       types.Int64    `tfsdk:"ingested"`
+	Failed         types.Int64    `tfsdk:"failed"`
+	ProcessedBytes types.Int64    `tfsdk:"processed_bytes"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatasetIngestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_ingest"
+}
+
+func (r *DatasetIngestResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Ingests a fixed set of events into a dataset, for example to seed the datasets of preview environments. " +
+			"The events are ingested when the resource is created and again whenever `dataset`, `content`, `file`, `content_hash` or `format` change. " +
+			"Destroying the resource doesn't remove the ingested events.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Dataset ingest identifier in the format `dataset/sha256`, where sha256 is the hash of the ingested data",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Dataset to ingest the events into",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Events to ingest as a JSON array or as newline delimited JSON objects. Exactly one of content or file must be set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("file")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path of a file holding the events to ingest as a JSON array or as newline delimited JSON objects. Requires content_hash, which should change with the content of the file, for example `filesha256(path)`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("content_hash")),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Hash of the events to ingest. The events are ingested again when it changes",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Format of the events. Must be one of: 'json', 'ndjson'. Detected from the events if not set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ingestFormatJSON, ingestFormatNDJSON),
+				},
+			},
+			"ingested": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of events that were ingested",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"failed": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of events that failed to ingest",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"processed_bytes": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of bytes processed by the ingest",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *DatasetIngestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *DatasetIngestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DatasetIngestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "dataset ingest", resourceAddress("axiom_dataset_ingest", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	data := []byte(plan.Content.ValueString())
+	if !plan.File.IsNull() {
+		var err error
+		if data, err = os.ReadFile(plan.File.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file"), "Unable to read file", err.Error())
+			return
+		}
+	}
+
+	contentType := ingestContentType(plan.Format.ValueString(), data)
+
+	status, err := r.client.Datasets.Ingest(ctx, plan.Dataset.ValueString(), bytes.NewReader(data), contentType, axiom.Identity)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ingest events, got error: %s", err))
+		return
+	}
+
+	if status.Failed > 0 {
+		resp.Diagnostics.AddWarning(
+			"Events Failed To Ingest",
+			fmt.Sprintf("%d of %d events failed to ingest into dataset %s: %s", status.Failed, status.Ingested+status.Failed, plan.Dataset.ValueString(), ingestFailureSummary(status.Failures)),
+		)
+	}
+
+	sum := sha256.Sum256(data)
+	plan.ID = types.StringValue(plan.Dataset.ValueString() + "/" + hex.EncodeToString(sum[:]))
+	plan.Ingested = types.Int64Value(int64(status.Ingested))
+	plan.Failed = types.Int64Value(int64(status.Failed))
+	plan.ProcessedBytes = types.Int64Value(int64(status.ProcessedBytes))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetIngestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var plan DatasetIngestResourceModel
+
+	// An ingest has no remote state to refresh, so the state is kept as is.
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetIngestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatasetIngestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires a replacement, so only the timeouts can
+	// change in place.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetIngestResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Ingested events can't be removed individually. Use axiom_dataset_trim or
+	// delete the dataset to get rid of them.
+}
+
+// ingestContentType returns the content type of the given format. Without a
+// format, data holding a JSON array is ingested as JSON and anything else as
+// newline delimited JSON.
+func ingestContentType(format string, data []byte) axiom.ContentType {
+	switch format {
+	case ingestFormatJSON:
+		return axiom.JSON
+	case ingestFormatNDJSON:
+		return axiom.NDJSON
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return axiom.JSON
+	}
+
+	return axiom.NDJSON
+}
+
+// ingestFailureSummary describes the first failures of an ingest.
+func ingestFailureSummary(failures []*ingest.Failure) string {
+	errs := make([]string, 0, maxReportedIngestFailures)
+	for _, failure := range failures {
+		if len(errs) == maxReportedIngestFailures {
+			errs = append(errs, fmt.Sprintf("and %d more", len(failures)-maxReportedIngestFailures))
+			break
+		}
+		if failure != nil {
+			errs = append(errs, failure.Error)
+		}
+	}
+
+	return strings.Join(errs, "; ")
+}
@@ -0,0 +1,154 @@
+package axiom
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+func TestIngestContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		format string
+		data   string
+		want   axiom.ContentType
+	}{
+		{name: "json array", data: " \n[{\"a\":1}]", want: axiom.JSON},
+		{name: "ndjson", data: "{\"a\":1}\n{\"a\":2}", want: axiom.NDJSON},
+		{name: "explicit json", format: "json", data: "{\"a\":1}", want: axiom.JSON},
+		{name: "explicit ndjson", format: "ndjson", data: "[{\"a\":1}]", want: axiom.NDJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, ingestContentType(tt.format, []byte(tt.data)))
+		})
+	}
+}
+
+func TestDatasetIngestResourceCreate(t *testing.T) {
+	t.Parallel()
+
+	events := "{\"status\":200}\n{\"status\":500}\n"
+	file := filepath.Join(t.TempDir(), "events.ndjson")
+	require.NoError(t, os.WriteFile(file, []byte(events), 0o600))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/datasets/logs/ingest", r.URL.Path)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, events, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ingested":1,"failed":1,"failures":[{"error":"invalid timestamp"}],"processedBytes":32}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	r := &DatasetIngestResource{client: newTestClient(t, srv.URL)}
+	plan := newTestState(t, r, DatasetIngestResourceModel{
+		ID:             types.StringUnknown(),
+		Dataset:        types.StringValue("logs"),
+		Content:        types.StringNull(),
+		File:           types.StringValue(file),
+		ContentHash:    types.StringValue("v1"),
+		Format:         types.StringNull(),
+		Ingested:       types.Int64Unknown(),
+		Failed:         types.Int64Unknown(),
+		ProcessedBytes: types.Int64Unknown(),
+		Timeouts:       nullTimeouts(),
+	})
+
+	resp := resource.CreateResponse{State: tfsdk.State{Raw: plan.Raw, Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Raw: plan.Raw, Schema: plan.Schema},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Equal(t, "Events Failed To Ingest", resp.Diagnostics.Warnings()[0].Summary())
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "invalid timestamp")
+
+	var state DatasetIngestResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+
+	assert.Regexp(t, "^logs/[0-9a-f]{64}$", state.ID.ValueString())
+	assert.EqualValues(t, 1, state.Ingested.ValueInt64())
+	assert.EqualValues(t, 1, state.Failed.ValueInt64())
+	assert.EqualValues(t, 32, state.ProcessedBytes.ValueInt64())
+}
+
+func TestDatasetIngestURL(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "https://api.eu.axiom.co")
+	edgeURL, err := url.Parse("https://edge.example.com")
+	require.NoError(t, err)
+	customEdgeURL, err := url.Parse("https://edge.example.com/custom/ingest")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		edge    edgeConfig
+		dataset *axiom.Dataset
+		want    string
+	}{
+		{
+			name:    "api without edge deployment",
+			dataset: &axiom.Dataset{ID: "logs"},
+			want:    "https://api.eu.axiom.co/v1/datasets/logs/ingest",
+		},
+		{
+			name:    "edge deployment without edge endpoint",
+			dataset: &axiom.Dataset{ID: "logs", EdgeDeployment: "cloud.eu-central-1.aws"},
+			want:    "",
+		},
+		{
+			name:    "edge domain",
+			edge:    edgeConfig{domain: "eu-central-1.aws.edge.axiom.co"},
+			dataset: &axiom.Dataset{ID: "logs", EdgeDeployment: "cloud.eu-central-1.aws"},
+			want:    "https://eu-central-1.aws.edge.axiom.co/v1/ingest/logs",
+		},
+		{
+			name:    "edge url takes precedence over domain",
+			edge:    edgeConfig{url: edgeURL, domain: "eu-central-1.aws.edge.axiom.co"},
+			dataset: &axiom.Dataset{ID: "logs"},
+			want:    "https://edge.example.com/v1/ingest/logs",
+		},
+		{
+			name:    "edge url with custom path",
+			edge:    edgeConfig{url: customEdgeURL},
+			dataset: &axiom.Dataset{ID: "logs"},
+			want:    "https://edge.example.com/custom/ingest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := datasetIngestURL(context.Background(), client, tt.edge, tt.dataset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DatasetMapFieldResource{}
+	_ resource.ResourceWithImportState = &DatasetMapFieldResource{}
+)
+
+func NewDatasetMapFieldResource() resource.Resource {
+	return &DatasetMapFieldResource{}
+}
+
+// DatasetMapFieldResource defines the resource implementation.
+type DatasetMapFieldResource struct {
+	client        *axiom.Client
+	mapFieldLocks *datasetLocks
+}
+
+// DatasetMapFieldResourceModel describes the resource data model.
+type DatasetMapFieldResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Dataset  types.String   `tfsdk:"dataset"`
+	Name     types.String   `tfsdk:"name"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatasetMapFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_map_field"
+}
+
+func (r *DatasetMapFieldResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a single map field to a dataset without managing the other map fields of the dataset. " +
+			"The `map_fields` attribute of `axiom_dataset` is authoritative and removes map fields added by this resource, " +
+			"so leave it unset on datasets whose map fields are managed with `axiom_dataset_map_field`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Map field identifier in the format `dataset/name`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Dataset the map field belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the map field",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(validMapFieldNameRe, "Invalid field name format"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *DatasetMapFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+	r.mapFieldLocks = data.mapFieldLocks
+}
+
+func (r *DatasetMapFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DatasetMapFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "dataset map field", resourceAddress("axiom_dataset_map_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	if err := addMapField(ctx, r.client, r.mapFieldLocks, plan.Dataset.ValueString(), plan.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add map field, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Dataset.ValueString() + "/" + plan.Name.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetMapFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var plan DatasetMapFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "dataset map field", resourceAddress("axiom_dataset_map_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapFields, err := r.client.Datasets.ListMapFields(ctx, plan.Dataset.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Unable to read dataset map fields", err.Error())
+		return
+	}
+
+	if !slices.Contains(mapFields, plan.Name.ValueString()) {
+		resp.Diagnostics.AddWarning(
+			"Map Field Not Found",
+			fmt.Sprintf("Map field %s of dataset %s does not exist and will be recreated if still defined in the configuration.", plan.Name.ValueString(), plan.Dataset.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Dataset.ValueString() + "/" + plan.Name.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetMapFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatasetMapFieldResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The dataset and name require a replacement, so only the timeouts can
+	// change in place.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetMapFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan DatasetMapFieldResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "dataset map field", resourceAddress("axiom_dataset_map_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := removeMapField(ctx, r.client, r.mapFieldLocks, plan.Dataset.ValueString(), plan.Name.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Failed to remove map field", err.Error())
+		return
+	}
+}
+
+func (r *DatasetMapFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	dataset, name, diags := parseDatasetScopedID(req.ID, "name")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dataset"), dataset)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// addMapField adds the map field to the map fields of the dataset, keeping
+// the others. The dataset is locked for the read-modify-write cycle.
+func addMapField(ctx context.Context, client *axiom.Client, locks *datasetLocks, dataset, name string) error {
+	defer locks.Lock(dataset)()
+
+	mapFields, err := client.Datasets.ListMapFields(ctx, dataset)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(mapFields, name) {
+		return nil
+	}
+
+	_, err = client.Datasets.UpdateMapFields(ctx, dataset, append(mapFields, name))
+	return err
+}
+
+// removeMapField removes the map field from the map fields of the dataset,
+// keeping the others. The dataset is locked for the read-modify-write cycle.
+func removeMapField(ctx context.Context, client *axiom.Client, locks *datasetLocks, dataset, name string) error {
+	defer locks.Lock(dataset)()
+
+	mapFields, err := client.Datasets.ListMapFields(ctx, dataset)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(mapFields, name) {
+		return nil
+	}
+
+	_, err = client.Datasets.UpdateMapFields(ctx, dataset, slices.DeleteFunc(mapFields, func(mapField string) bool {
+		return mapField == name
+	}))
+	return err
+}
@@ -0,0 +1,146 @@
+package axiom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMapFieldsServer serves the map fields endpoints of a single dataset. The
+// handler yields between reading and writing requests, so read-modify-write
+// cycles that aren't serialized lose updates.
+func newMapFieldsServer(t *testing.T, initial ...string) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	mapFields := append([]string{}, initial...)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/datasets/logs/mapfields", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			current := append([]string{}, mapFields...)
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+			_ = json.NewEncoder(w).Encode(current)
+		case http.MethodPut:
+			var updated []string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+
+			mu.Lock()
+			mapFields = updated
+			mu.Unlock()
+
+			_ = json.NewEncoder(w).Encode(updated)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, mapFields...)
+	}
+}
+
+func TestAddMapField(t *testing.T) {
+	t.Parallel()
+
+	srv, mapFields := newMapFieldsServer(t, "existing")
+	client := newTestClient(t, srv.URL)
+	locks := newDatasetLocks()
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			assert.NoError(t, addMapField(context.Background(), client, locks, "logs", fmt.Sprintf("field%d", i)))
+		})
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []string{
+		"existing", "field0", "field1", "field2", "field3", "field4",
+		"field5", "field6", "field7", "field8", "field9",
+	}, mapFields())
+}
+
+func TestRemoveMapField(t *testing.T) {
+	t.Parallel()
+
+	srv, mapFields := newMapFieldsServer(t, "a", "b", "c", "d")
+	client := newTestClient(t, srv.URL)
+	locks := newDatasetLocks()
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "c", "missing"} {
+		wg.Go(func() {
+			assert.NoError(t, removeMapField(context.Background(), client, locks, "logs", name))
+		})
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []string{"b", "d"}, mapFields())
+}
+
+func TestRemovedMapFields(t *testing.T) {
+	t.Parallel()
+
+	list := func(values ...string) types.List {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	assert.Equal(t, []string{"b"}, removedMapFields(list("a", "b"), list("a", "c")))
+	assert.Empty(t, removedMapFields(list("a"), list("a", "b")))
+	assert.Empty(t, removedMapFields(list("a"), types.ListUnknown(types.StringType)))
+	assert.Empty(t, removedMapFields(types.ListNull(types.StringType), list("a")))
+}
+
+func TestDatasetLocks(t *testing.T) {
+	t.Parallel()
+
+	locks := newDatasetLocks()
+
+	unlock := locks.Lock("logs")
+
+	// Other datasets aren't blocked.
+	locks.Lock("traces")()
+
+	locked := make(chan struct{})
+	go func() {
+		defer locks.Lock("logs")()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		require.Fail(t, "dataset was locked twice")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	unlock()
+	<-locked
+
+	// A nil set of locks doesn't lock at all.
+	var nilLocks *datasetLocks
+	nilLocks.Lock("logs")()
+}
@@ -0,0 +1,216 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &DatasetTrimResource{}
+	_ resource.ResourceWithValidateConfig = &DatasetTrimResource{}
+)
+
+func NewDatasetTrimResource() resource.Resource {
+	return &DatasetTrimResource{}
+}
+
+// DatasetTrimResource defines the resource implementation.
+type DatasetTrimResource struct {
+	client *axiom.Client
+}
+
+// DatasetTrimResourceModel describes the resource data model.
+type DatasetTrimResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Dataset     types.String   `tfsdk:"dataset"`
+	MaxDuration types.String   `tfsdk:"max_duration"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	TrimmedAt   types.String   `tfsdk:"trimmed_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DatasetTrimResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_trim"
+}
+
+func (r *DatasetTrimResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Trims a dataset by deleting all of its data older than `max_duration`. The dataset is trimmed " +
+			"when the resource is created and again whenever `dataset`, `max_duration` or `triggers` change. " +
+			"Destroying the resource doesn't restore any data.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Dataset trim identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dataset": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Dataset to trim",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"max_duration": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Data older than this duration is deleted. Must be a valid Go duration such as `24h` or `720h`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that trim the dataset again when they change",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"trimmed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the dataset was trimmed in RFC3339 format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *DatasetTrimResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *DatasetTrimResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var maxDuration types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_duration"), &maxDuration)...)
+	if resp.Diagnostics.HasError() || maxDuration.IsNull() || maxDuration.IsUnknown() {
+		return
+	}
+
+	_, diags := parseTrimMaxDuration(maxDuration)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *DatasetTrimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DatasetTrimResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "dataset trim", resourceAddress("axiom_dataset_trim", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	maxDuration, diags := parseTrimMaxDuration(plan.MaxDuration)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Datasets.Trim(ctx, plan.Dataset.ValueString(), maxDuration); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to trim dataset, got error: %s", err))
+		return
+	}
+
+	trimmedAt := time.Now().UTC().Format(time.RFC3339)
+	plan.ID = types.StringValue(plan.Dataset.ValueString() + "/" + trimmedAt)
+	plan.TrimmedAt = types.StringValue(trimmedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetTrimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var plan DatasetTrimResourceModel
+
+	// A trim has no remote state to refresh, so the state is kept as is.
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetTrimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DatasetTrimResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every other attribute requires a replacement, so only the timeouts can
+	// change in place.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DatasetTrimResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Trimmed data can't be restored, so there is nothing to delete.
+}
+
+// parseTrimMaxDuration parses the max duration of a dataset trim, which must
+// be greater than zero.
+func parseTrimMaxDuration(value types.String) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	maxDuration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("max_duration"),
+			"Invalid Max Duration",
+			fmt.Sprintf("Expected a valid Go duration such as 24h or 720h, got %q: %s", value.ValueString(), err),
+		)
+		return 0, diags
+	}
+
+	if maxDuration <= 0 {
+		diags.AddAttributeError(
+			path.Root("max_duration"),
+			"Invalid Max Duration",
+			"Max duration must be greater than zero.",
+		)
+		return 0, diags
+	}
+
+	return maxDuration, diags
+}
@@ -0,0 +1,95 @@
+package axiom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrimMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "24h", want: 24 * time.Hour},
+		{value: "90m", want: 90 * time.Minute},
+		{value: "30d", wantErr: true},
+		{value: "0s", wantErr: true},
+		{value: "-1h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := parseTrimMaxDuration(types.StringValue(tt.value))
+			if tt.wantErr {
+				require.True(t, diags.HasError())
+				assert.Equal(t, "Invalid Max Duration", diags[0].Summary())
+				return
+			}
+
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDatasetTrimResourceCreate(t *testing.T) {
+	t.Parallel()
+
+	var trimmed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v2/datasets/logs/trim", r.URL.Path)
+
+		var req struct {
+			MaxDuration string `json:"maxDuration"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "720h0m0s", req.MaxDuration)
+
+		trimmed = true
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	r := &DatasetTrimResource{client: newTestClient(t, srv.URL)}
+	plan := newTestState(t, r, DatasetTrimResourceModel{
+		ID:          types.StringUnknown(),
+		Dataset:     types.StringValue("logs"),
+		MaxDuration: types.StringValue("720h"),
+		Triggers:    types.MapValueMust(types.StringType, map[string]attr.Value{"reset": types.StringValue("1")}),
+		TrimmedAt:   types.StringUnknown(),
+		Timeouts:    nullTimeouts(),
+	})
+
+	resp := resource.CreateResponse{State: tfsdk.State{Raw: plan.Raw, Schema: plan.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Raw: plan.Raw, Schema: plan.Schema},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, trimmed)
+
+	var state DatasetTrimResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+
+	trimmedAt, err := time.Parse(time.RFC3339, state.TrimmedAt.ValueString())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), trimmedAt, time.Minute)
+	assert.Equal(t, "logs/"+state.TrimmedAt.ValueString(), state.ID.ValueString())
+}
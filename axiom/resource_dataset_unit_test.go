@@ -1,10 +1,20 @@
 package axiom
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/axiomhq/axiom-go/axiom"
 )
@@ -142,3 +152,285 @@ func TestEdgeDeploymentValue(t *testing.T) {
 		assert.Equal(t, "cloud.eu-central-1.aws", edgeDeploymentValue(types.StringValue("cloud.eu-central-1.aws")))
 	})
 }
+
+func TestDatasetResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	state := upgradeStateFromJSON(t, &DatasetResource{}, 0, `{
+		"id": "logs",
+		"name": "logs",
+		"description": "Application logs"
+	}`)
+
+	var upgraded datasetResourceState
+	require.False(t, state.Get(context.Background(), &upgraded).HasError())
+
+	assert.Equal(t, "logs", upgraded.ID.ValueString())
+	assert.Equal(t, "logs", upgraded.Name.ValueString())
+	assert.Equal(t, "Application logs", upgraded.Description.ValueString())
+	assert.Equal(t, "axiom:events:v1", upgraded.Kind.ValueString())
+	assert.True(t, upgraded.EdgeDeployment.IsNull())
+	assert.True(t, upgraded.RetentionDays.IsNull())
+	assert.True(t, upgraded.MapFields.IsNull())
+	assert.True(t, upgraded.DeletionProtection.ValueBool())
+}
+
+func newDatasetTestModel(name, kind string, deletionProtection bool) datasetResourceState {
+	return datasetResourceState{
+		DatasetResourceModel: DatasetResourceModel{
+			ID:                 types.StringValue(name),
+			Name:               types.StringValue(name),
+			Kind:               types.StringValue(kind),
+			Description:        types.StringNull(),
+			EdgeDeployment:     types.StringValue("cloud.us-east-1.aws"),
+			UseRetentionPeriod: types.BoolValue(false),
+			RetentionDays:      types.Int64Value(0),
+			MapFields:          types.ListNull(types.StringType),
+		},
+		DeletionProtection: types.BoolValue(deletionProtection),
+		Timeouts:           nullTimeouts(),
+	}
+}
+
+func TestDatasetResourceDelete_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	// The client isn't set, so the dataset can't be deleted by accident.
+	var resp resource.DeleteResponse
+	(&DatasetResource{}).Delete(context.Background(), resource.DeleteRequest{
+		State: newTestState(t, &DatasetResource{}, newDatasetTestModel("logs", "axiom:events:v1", true)),
+	}, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Dataset Deletion Protected", resp.Diagnostics[0].Summary())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "deletion_protection = false")
+}
+
+func TestDatasetResourceModifyPlan_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		state     datasetResourceState
+		plan      datasetResourceState
+		wantPaths []path.Path
+	}{
+		{
+			name:  "in-place update",
+			state: newDatasetTestModel("logs", "axiom:events:v1", true),
+			plan:  newDatasetTestModel("logs", "axiom:events:v1", false),
+		},
+		{
+			name:      "rename",
+			state:     newDatasetTestModel("logs", "axiom:events:v1", true),
+			plan:      newDatasetTestModel("app-logs", "axiom:events:v1", true),
+			wantPaths: []path.Path{path.Root("name")},
+		},
+		{
+			name:      "rename and kind change",
+			state:     newDatasetTestModel("logs", "axiom:events:v1", true),
+			plan:      newDatasetTestModel("app-logs", "otel:logs:v1", true),
+			wantPaths: []path.Path{path.Root("name"), path.Root("kind")},
+		},
+		{
+			name:      "disabled in the same apply",
+			state:     newDatasetTestModel("logs", "axiom:events:v1", true),
+			plan:      newDatasetTestModel("app-logs", "axiom:events:v1", false),
+			wantPaths: []path.Path{path.Root("name")},
+		},
+		{
+			name:  "disabled in a previous apply",
+			state: newDatasetTestModel("logs", "axiom:events:v1", false),
+			plan:  newDatasetTestModel("app-logs", "axiom:events:v1", false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := newTestState(t, &DatasetResource{}, tt.state)
+			plan := newTestState(t, &DatasetResource{}, tt.plan)
+
+			var resp resource.ModifyPlanResponse
+			(&DatasetResource{}).ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+				State: state,
+				Plan:  tfsdk.Plan{Raw: plan.Raw, Schema: plan.Schema},
+			}, &resp)
+
+			var gotPaths []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				assert.Equal(t, "Dataset Deletion Protected", d.Summary())
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					gotPaths = append(gotPaths, withPath.Path())
+				}
+			}
+			assert.Equal(t, tt.wantPaths, gotPaths)
+		})
+	}
+}
+
+func TestValidateDatasetRetention(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		use     types.Bool
+		days    types.Int64
+		wantErr string
+	}{
+		{name: "retention disabled", use: types.BoolValue(false), days: types.Int64Value(0)},
+		{name: "retention not configured", use: types.BoolNull(), days: types.Int64Null()},
+		{name: "valid retention", use: types.BoolValue(true), days: types.Int64Value(30)},
+		{name: "zero days", use: types.BoolValue(true), days: types.Int64Value(0), wantErr: "greater than 0"},
+		{name: "negative days", use: types.BoolNull(), days: types.Int64Value(-1), wantErr: "must not be negative"},
+		{name: "days not configured", use: types.BoolValue(true), days: types.Int64Null(), wantErr: "greater than 0"},
+		{name: "days unknown while planning", use: types.BoolValue(true), days: types.Int64Unknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateDatasetRetention(DatasetResourceModel{
+				UseRetentionPeriod: tt.use,
+				RetentionDays:      tt.days,
+			})
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+
+			require.True(t, diags.HasError())
+			assert.Equal(t, "Invalid Retention", diags[0].Summary())
+			assert.Contains(t, diags[0].Detail(), tt.wantErr)
+			assert.Equal(t, path.Root("retention_days"), diags[0].(diag.DiagnosticWithPath).Path())
+		})
+	}
+}
+
+func TestRetentionReduction(t *testing.T) {
+	t.Parallel()
+
+	retention := func(use bool, days int64) DatasetResourceModel {
+		return DatasetResourceModel{UseRetentionPeriod: types.BoolValue(use), RetentionDays: types.Int64Value(days)}
+	}
+
+	tests := []struct {
+		name        string
+		state       DatasetResourceModel
+		plan        DatasetResourceModel
+		wantOld     int64
+		wantNew     int64
+		wantReduced bool
+	}{
+		{name: "decrease", state: retention(true, 90), plan: retention(true, 30), wantOld: 90, wantNew: 30, wantReduced: true},
+		{name: "increase", state: retention(true, 30), plan: retention(true, 90), wantOld: 30, wantNew: 90},
+		{name: "unchanged", state: retention(true, 30), plan: retention(true, 30), wantOld: 30, wantNew: 30},
+		{name: "enabled on unlimited dataset", state: retention(false, 0), plan: retention(true, 30), wantNew: 30, wantReduced: true},
+		{name: "disabled", state: retention(true, 30), plan: retention(false, 0), wantOld: 30},
+		{
+			name:    "unknown",
+			state:   retention(true, 90),
+			plan:    DatasetResourceModel{UseRetentionPeriod: types.BoolValue(true), RetentionDays: types.Int64Unknown()},
+			wantOld: 90,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			oldDays, newDays, reduced := retentionReduction(tt.state, tt.plan)
+			assert.Equal(t, tt.wantOld, oldDays)
+			assert.Equal(t, tt.wantNew, newDays)
+			assert.Equal(t, tt.wantReduced, reduced)
+		})
+	}
+}
+
+func TestEstimateRetentionLoss(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	stats := datasetStats{
+		NumEvents:       1000,
+		CompressedBytes: 4000,
+		MinTime:         now.AddDate(0, 0, -100),
+		MaxTime:         now,
+	}
+
+	events, bytes := estimateRetentionLoss(stats, 25, now)
+	assert.EqualValues(t, 750, events)
+	assert.EqualValues(t, 3000, bytes)
+
+	events, bytes = estimateRetentionLoss(stats, 200, now)
+	assert.Zero(t, events)
+	assert.Zero(t, bytes)
+
+	// Datasets that stopped receiving data before the cutoff lose everything.
+	stats.MaxTime = now.AddDate(0, 0, -50)
+	events, bytes = estimateRetentionLoss(stats, 25, now)
+	assert.EqualValues(t, 1000, events)
+	assert.EqualValues(t, 4000, bytes)
+
+	events, _ = estimateRetentionLoss(datasetStats{}, 25, now)
+	assert.Zero(t, events)
+}
+
+func TestDatasetResourceCheckRetentionReduction(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/datasets/_stats", r.URL.Path)
+
+		minTime := time.Now().AddDate(0, 0, -100).UTC().Format(time.RFC3339)
+		maxTime := time.Now().UTC().Format(time.RFC3339)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"datasets":[{"name":"logs","numEvents":1000,"compressedBytes":4000,"minTime":%q,"maxTime":%q}]}`, minTime, maxTime)
+	}))
+	t.Cleanup(srv.Close)
+
+	r := &DatasetResource{client: newTestClient(t, srv.URL)}
+
+	state := newDatasetTestModel("logs", "axiom:events:v1", true)
+	state.UseRetentionPeriod = types.BoolValue(true)
+	state.RetentionDays = types.Int64Value(90)
+
+	plan := state
+	plan.RetentionDays = types.Int64Value(25)
+
+	tests := []struct {
+		name      string
+		confirm   types.Bool
+		wantError bool
+	}{
+		{name: "not set", confirm: types.BoolNull()},
+		{name: "not confirmed", confirm: types.BoolValue(false), wantError: true},
+		{name: "confirmed", confirm: types.BoolValue(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := plan
+			plan.ConfirmRetentionReduction = tt.confirm
+
+			diags := r.checkRetentionReduction(context.Background(), state.DatasetResourceModel, plan)
+
+			require.Len(t, diags.Warnings(), 1)
+			assert.Equal(t, "Retention Reduction Deletes Data", diags.Warnings()[0].Summary())
+			assert.Contains(t, diags.Warnings()[0].Detail(), "from 90 days to 25 days")
+			assert.Contains(t, diags.Warnings()[0].Detail(), "approximately 750 events (3000 compressed bytes)")
+
+			if !tt.wantError {
+				assert.False(t, diags.HasError())
+				return
+			}
+			require.Len(t, diags.Errors(), 1)
+			assert.Equal(t, "Retention Reduction Not Confirmed", diags.Errors()[0].Summary())
+		})
+	}
+}
@@ -2,10 +2,16 @@ package axiom
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,15 +24,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/axiomhq/axiom-go/axiom"
+	aplquery "github.com/axiomhq/axiom-go/axiom/query"
+
+	"terraform-provider-axiom-provider/internal/apl"
 )
 
+// monitorDryRunRange is the time range the queries of monitors are run over
+// when they are validated against the query API.
+const monitorDryRunRange = time.Minute
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &MonitorResource{}
-	_ resource.ResourceWithImportState = &MonitorResource{}
+	_ resource.Resource                     = &MonitorResource{}
+	_ resource.ResourceWithImportState      = &MonitorResource{}
+	_ resource.ResourceWithUpgradeState     = &MonitorResource{}
+	_ resource.ResourceWithValidateConfig   = &MonitorResource{}
+	_ resource.ResourceWithConfigValidators = &MonitorResource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorResource{}
+	_ resource.ConfigValidator              = monitorTypeValidator{}
 )
 
 func NewMonitorResource() resource.Resource {
@@ -35,7 +55,8 @@ func NewMonitorResource() resource.Resource {
 
 // MonitorResource defines the resource implementation.
 type MonitorResource struct {
-	client *axiom.Client
+	client          *axiom.Client
+	validateQueries bool
 }
 
 // MonitorResourceModel describes the resource data model.
@@ -48,12 +69,15 @@ type MonitorResourceModel struct {
 	APLQuery                     types.String  `tfsdk:"apl_query"`
 	DisabledUntil                types.String  `tfsdk:"disabled_until"`
 	IntervalMinutes              types.Int64   `tfsdk:"interval_minutes"`
+	Interval                     durationValue `tfsdk:"interval"`
 	NotifierIds                  types.List    `tfsdk:"notifier_ids"`
 	Operator                     types.String  `tfsdk:"operator"`
 	RangeMinutes                 types.Int64   `tfsdk:"range_minutes"`
+	Range                        durationValue `tfsdk:"range"`
 	Threshold                    types.Float64 `tfsdk:"threshold"`
 	Resolvable                   types.Bool    `tfsdk:"resolvable"`
 	Delay                        types.Int64   `tfsdk:"delay"`
+	DelayDuration                durationValue `tfsdk:"delay_duration"`
 	NotifyEveryRun               types.Bool    `tfsdk:"notify_every_run"`
 	SkipResolved                 types.Bool    `tfsdk:"skip_resolved"`
 	Tolerance                    types.Float64 `tfsdk:"tolerance"`
@@ -65,11 +89,18 @@ type MonitorResourceModel struct {
 	CreatedAt                    types.String  `tfsdk:"created_at"`
 }
 
+// monitorResourceModelWithTimeouts adds the timeouts block, which only the
+// managed resource exposes, to the monitor model shared with the data source.
+type monitorResourceModelWithTimeouts struct {
+	MonitorResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *MonitorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_monitor"
 }
 
-func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MonitorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
@@ -101,7 +132,7 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Default:             booldefault.StaticBool(false),
 			},
 			"apl_query": schema.StringAttribute{
-				MarkdownDescription: "The query used inside the monitor",
+				MarkdownDescription: "The query used inside the monitor. Its APL syntax is checked without calling the API when the configuration is validated. Operators and tables the provider doesn't know are reported as warnings and left to the API",
 				Required:            true,
 			},
 			"disabled_until": schema.StringAttribute{
@@ -112,10 +143,19 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"interval_minutes": schema.Int64Attribute{
-				MarkdownDescription: "How often the monitor should run",
+				MarkdownDescription: "How often the monitor should run in minutes. Defaults to 1. Conflicts with interval",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+			},
+			"interval": schema.StringAttribute{
+				CustomType: durationType{},
+				MarkdownDescription: "How often the monitor should run, as a Go duration such as `5m` or an ISO 8601 duration such as `PT5M`. " +
+					"Must be a whole number of minutes. Defaults to `1m`. Conflicts with interval_minutes",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("interval_minutes")),
+				},
 			},
 			"notifier_ids": schema.ListAttribute{
 				Optional:    true,
@@ -137,10 +177,19 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"range_minutes": schema.Int64Attribute{
-				MarkdownDescription: "Query time range from now",
+				MarkdownDescription: "Query time range from now in minutes. Defaults to 1. Conflicts with range",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(1),
+			},
+			"range": schema.StringAttribute{
+				CustomType: durationType{},
+				MarkdownDescription: "Query time range from now, as a Go duration such as `10m` or an ISO 8601 duration such as `PT10M`. " +
+					"Must be a whole number of minutes and at least the interval. Defaults to `1m`. Conflicts with range_minutes",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("range_minutes")),
+				},
 			},
 			"threshold": schema.Float64Attribute{
 				MarkdownDescription: "The threshold where the monitor should trigger",
@@ -156,10 +205,19 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed: true,
 			},
 			"delay": schema.Int64Attribute{
-				MarkdownDescription: "The delay in seconds before the monitor runs (useful for situations where data is batched/delayed)",
+				MarkdownDescription: "The delay in seconds before the monitor runs (useful for situations where data is batched/delayed). Defaults to 0. Conflicts with delay_duration",
 				Optional:            true,
 				Computed:            true,
-				Default:             int64default.StaticInt64(0),
+			},
+			"delay_duration": schema.StringAttribute{
+				CustomType: durationType{},
+				MarkdownDescription: "The delay before the monitor runs (useful for situations where data is batched/delayed), as a Go duration such as `30s` " +
+					"or an ISO 8601 duration such as `PT30S`. Must be a whole number of seconds. Defaults to `0s`. Conflicts with delay",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("delay")),
+				},
 			},
 			"notify_every_run": schema.BoolAttribute{
 				MarkdownDescription: "Indicates whether to send notifications on every trigger",
@@ -217,41 +275,75 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
 func (r *MonitorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	client, ok := req.ProviderData.(*axiom.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	r.client = data.client
+	r.validateQueries = data.validateQueries
+}
+
+func (r *MonitorResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		monitorTypeValidator{},
+	}
+}
+
+func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	_, diags := resolveMonitorDurations(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
 
+	var query, monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("apl_query"), &query)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || query.IsNull() || query.IsUnknown() {
 		return
 	}
 
-	r.client = client
+	resp.Diagnostics.Append(validateMonitorQuery(query.ValueString(), monitorType.ValueString())...)
+}
+
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planMonitorDurations(ctx, req.Config, &resp.Plan)...)
+	if resp.Diagnostics.HasError() || !r.validateQueries || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(dryRunPlannedMonitorQuery(ctx, r.client, req.State, resp.Plan)...)
 }
 
 func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan MonitorResourceModel
+	var plan monitorResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "monitor", resourceAddress("axiom_monitor", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	monitor, diags := extractMonitorResourceModel(ctx, plan)
+	monitor, diags := extractMonitorResourceModel(ctx, plan.MonitorResourceModel)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -263,17 +355,26 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenMonitor(monitor))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorResourceModelWithTimeouts{
+		MonitorResourceModel: flattenMonitor(monitor),
+		Timeouts:             plan.Timeouts,
+	})...)
 }
 
 func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan MonitorResourceModel
+	var plan monitorResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "monitor", resourceAddress("axiom_monitor", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	monitor, err := r.client.Monitors.Get(ctx, plan.ID.ValueString())
 	if err != nil {
 		if isNotFoundError(err) {
@@ -288,18 +389,27 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenMonitor(monitor))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorResourceModelWithTimeouts{
+		MonitorResourceModel: flattenMonitor(monitor),
+		Timeouts:             plan.Timeouts,
+	})...)
 }
 
 func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan MonitorResourceModel
+	var plan monitorResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	monitor, diags := extractMonitorResourceModel(ctx, plan)
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "monitor", resourceAddress("axiom_monitor", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, diags := extractMonitorResourceModel(ctx, plan.MonitorResourceModel)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -311,17 +421,26 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenMonitor(monitor))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorResourceModelWithTimeouts{
+		MonitorResourceModel: flattenMonitor(monitor),
+		Timeouts:             plan.Timeouts,
+	})...)
 }
 
 func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var plan MonitorResourceModel
+	var plan monitorResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "monitor", resourceAddress("axiom_monitor", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Monitors.Delete(ctx, plan.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete Monitor", err.Error())
 		return
@@ -332,6 +451,99 @@ func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// monitorResourceModelV0 describes the version 0 state of a monitor, written
+// when every monitor was a threshold monitor.
+type monitorResourceModelV0 struct {
+	ID              types.String  `tfsdk:"id"`
+	Name            types.String  `tfsdk:"name"`
+	Description     types.String  `tfsdk:"description"`
+	AlertOnNoData   types.Bool    `tfsdk:"alert_on_no_data"`
+	NotifyByGroup   types.Bool    `tfsdk:"notify_by_group"`
+	APLQuery        types.String  `tfsdk:"apl_query"`
+	DisabledUntil   types.String  `tfsdk:"disabled_until"`
+	IntervalMinutes types.Int64   `tfsdk:"interval_minutes"`
+	NotifierIds     types.List    `tfsdk:"notifier_ids"`
+	Operator        types.String  `tfsdk:"operator"`
+	RangeMinutes    types.Int64   `tfsdk:"range_minutes"`
+	Threshold       types.Float64 `tfsdk:"threshold"`
+	Resolvable      types.Bool    `tfsdk:"resolvable"`
+}
+
+func (r *MonitorResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":               schema.StringAttribute{Computed: true},
+					"name":             schema.StringAttribute{Required: true},
+					"description":      schema.StringAttribute{Optional: true},
+					"alert_on_no_data": schema.BoolAttribute{Optional: true},
+					"notify_by_group":  schema.BoolAttribute{Optional: true},
+					"apl_query":        schema.StringAttribute{Required: true},
+					"disabled_until":   schema.StringAttribute{Optional: true},
+					"interval_minutes": schema.Int64Attribute{Optional: true},
+					"notifier_ids": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"operator":      schema.StringAttribute{Optional: true},
+					"range_minutes": schema.Int64Attribute{Optional: true},
+					"threshold":     schema.Float64Attribute{Optional: true},
+					"resolvable":    schema.BoolAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeMonitorStateV0,
+		},
+	}
+}
+
+// upgradeMonitorStateV0 upgrades the state to version 1. Monitors without a
+// type were threshold monitors, and attributes that were added since are set
+// to their schema defaults so that the upgrade doesn't produce a diff.
+func upgradeMonitorStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior monitorResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	intervalMinutes := int64OrDefault(prior.IntervalMinutes, 1)
+	rangeMinutes := int64OrDefault(prior.RangeMinutes, 1)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorResourceModelWithTimeouts{
+		MonitorResourceModel: MonitorResourceModel{
+			ID:                           prior.ID,
+			Name:                         prior.Name,
+			Description:                  prior.Description,
+			AlertOnNoData:                boolOrDefault(prior.AlertOnNoData, false),
+			NotifyByGroup:                boolOrDefault(prior.NotifyByGroup, false),
+			APLQuery:                     prior.APLQuery,
+			DisabledUntil:                prior.DisabledUntil,
+			IntervalMinutes:              intervalMinutes,
+			Interval:                     newDurationValue(time.Duration(intervalMinutes.ValueInt64()) * time.Minute),
+			NotifierIds:                  prior.NotifierIds,
+			Operator:                     stringOrDefault(prior.Operator, ""),
+			RangeMinutes:                 rangeMinutes,
+			Range:                        newDurationValue(time.Duration(rangeMinutes.ValueInt64()) * time.Minute),
+			Threshold:                    float64OrDefault(prior.Threshold, 0),
+			Resolvable:                   boolOrDefault(prior.Resolvable, false),
+			Delay:                        types.Int64Value(0),
+			DelayDuration:                newDurationValue(0),
+			NotifyEveryRun:               types.BoolValue(false),
+			SkipResolved:                 types.BoolValue(false),
+			Tolerance:                    types.Float64Value(0),
+			TriggerFromNRuns:             types.Int64Value(1),
+			TriggerAfterNPositiveResults: types.Int64Value(0),
+			CompareDays:                  types.Int64Value(0),
+			Type:                         types.StringValue(axiom.MonitorTypeThreshold.String()),
+			CreatedBy:                    types.StringNull(),
+			CreatedAt:                    types.StringNull(),
+		},
+		Timeouts: nullTimeouts(),
+	})...)
+}
+
 func extractMonitorResourceModel(ctx context.Context, plan MonitorResourceModel) (*axiom.Monitor, diag.Diagnostics) {
 	notifierIds, diags := typeStringSliceToStringSlice(ctx, plan.NotifierIds.Elements())
 	if diags.HasError() {
@@ -377,7 +589,21 @@ func extractMonitorResourceModel(ctx context.Context, plan MonitorResourceModel)
 		return nil, diags
 	}
 
-	if diags = validateMonitor(plan); diags.HasError() {
+	interval, err := monitorDurationValue(plan.Interval, plan.IntervalMinutes, time.Minute)
+	if err != nil {
+		diags.AddAttributeError(path.Root("interval"), "Invalid Interval", err.Error())
+		return nil, diags
+	}
+
+	monitorRange, err := monitorDurationValue(plan.Range, plan.RangeMinutes, time.Minute)
+	if err != nil {
+		diags.AddAttributeError(path.Root("range"), "Invalid Range", err.Error())
+		return nil, diags
+	}
+
+	delay, err := monitorDurationValue(plan.DelayDuration, plan.Delay, time.Second)
+	if err != nil {
+		diags.AddAttributeError(path.Root("delay_duration"), "Invalid Delay", err.Error())
 		return nil, diags
 	}
 
@@ -388,13 +614,13 @@ func extractMonitorResourceModel(ctx context.Context, plan MonitorResourceModel)
 		APLQuery:                     plan.APLQuery.ValueString(),
 		Description:                  plan.Description.ValueString(),
 		DisabledUntil:                disabledUntil,
-		Interval:                     time.Duration(plan.IntervalMinutes.ValueInt64() * int64(time.Minute)),
+		Interval:                     interval,
 		NotifierIDs:                  notifierIds,
 		Operator:                     operator,
-		Range:                        time.Duration(plan.RangeMinutes.ValueInt64() * int64(time.Minute)),
+		Range:                        monitorRange,
 		Threshold:                    plan.Threshold.ValueFloat64(),
 		Resolvable:                   plan.Resolvable.ValueBool(),
-		Delay:                        time.Duration(plan.Delay.ValueInt64()) * time.Second,
+		Delay:                        delay,
 		NotifyEveryRun:               plan.NotifyEveryRun.ValueBool(),
 		SkipResolved:                 plan.SkipResolved.ValueBool(),
 		Tolerance:                    plan.Tolerance.ValueFloat64(),
@@ -424,13 +650,16 @@ func flattenMonitor(monitor *axiom.Monitor) MonitorResourceModel {
 		NotifyByGroup:                types.BoolValue(monitor.NotifyByGroup),
 		APLQuery:                     types.StringValue(monitor.APLQuery),
 		DisabledUntil:                disabledUntil,
-		IntervalMinutes:              types.Int64Value(int64(monitor.Interval.Minutes())),
+		IntervalMinutes:              types.Int64Value(int64(monitor.Interval / time.Minute)),
+		Interval:                     newDurationValue(monitor.Interval),
 		NotifierIds:                  flattenStringSlice(monitor.NotifierIDs),
 		Operator:                     types.StringValue(monitor.Operator.String()),
-		RangeMinutes:                 types.Int64Value(int64(monitor.Range.Minutes())),
+		RangeMinutes:                 types.Int64Value(int64(monitor.Range / time.Minute)),
+		Range:                        newDurationValue(monitor.Range),
 		Threshold:                    types.Float64Value(monitor.Threshold),
 		Resolvable:                   types.BoolValue(monitor.Resolvable),
-		Delay:                        types.Int64Value(int64(monitor.Delay.Seconds())),
+		Delay:                        types.Int64Value(int64(monitor.Delay / time.Second)),
+		DelayDuration:                newDurationValue(monitor.Delay),
 		NotifyEveryRun:               types.BoolValue(monitor.NotifyEveryRun),
 		SkipResolved:                 types.BoolValue(monitor.SkipResolved),
 		Tolerance:                    types.Float64Value(monitor.Tolerance),
@@ -443,67 +672,346 @@ func flattenMonitor(monitor *axiom.Monitor) MonitorResourceModel {
 	}
 }
 
-func validateMonitor(plan MonitorResourceModel) diag.Diagnostics {
+// monitorTypeAttributeRules lists per monitor type the type-specific
+// attributes that must be configured and those that have no effect. The
+// interval and range are not required, as they default to one minute.
+var monitorTypeAttributeRules = map[string]struct {
+	required []string
+	ignored  []string
+}{
+	axiom.MonitorTypeThreshold.String(): {
+		required: []string{"operator", "threshold"},
+		ignored:  []string{"tolerance", "compare_days", "resolvable"},
+	},
+	axiom.MonitorTypeAnomalyDetection.String(): {
+		required: []string{"operator", "compare_days", "tolerance"},
+		ignored:  []string{"threshold"},
+	},
+	axiom.MonitorTypeMatchEvent.String(): {
+		ignored: []string{"operator", "threshold", "tolerance", "compare_days"},
+	},
+}
+
+// monitorTypeValidator validates the type-specific attributes of a monitor
+// against the raw configuration, so that attributes with defaults count as
+// missing unless they are configured.
+type monitorTypeValidator struct{}
+
+func (v monitorTypeValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v monitorTypeValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the attributes required by the monitor type are configured"
+}
+
+func (v monitorTypeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || monitorType.IsNull() || monitorType.IsUnknown() {
+		return
+	}
+
+	rules, ok := monitorTypeAttributeRules[monitorType.ValueString()]
+	if !ok {
+		// The validator of the type attribute reports unknown types.
+		return
+	}
+
+	var config map[string]tftypes.Value
+	if err := req.Config.Raw.As(&config); err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+		return
+	}
+
+	for _, name := range rules.required {
+		if value, ok := config[name]; !ok || value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Attribute %s is required for monitor type %s.", name, monitorType.ValueString()),
+			)
+		}
+	}
+
+	for _, name := range rules.ignored {
+		if value, ok := config[name]; ok && !value.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(name),
+				"Attribute Has No Effect",
+				fmt.Sprintf("Attribute %s has no effect on monitor type %s and is ignored.", name, monitorType.ValueString()),
+			)
+		}
+	}
+}
+
+// validateMonitorQuery checks the syntax of the APL query of a monitor
+// without calling the API. Operators and tables the parser doesn't know may
+// be newer than the provider, so they are only reported as warnings. Threshold
+// monitors are expected to summarize by a bin of _time, but as the API also
+// accepts other queries only a warning is reported.
+func validateMonitorQuery(query string, monitorType string) diag.Diagnostics {
 	var diags diag.Diagnostics
-	switch plan.Type.ValueString() {
-	case axiom.MonitorTypeThreshold.String():
-		if plan.IntervalMinutes.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Interval is required",
-				"Interval is required for monitor type threshold",
-			))
+
+	parsed, err := apl.Parse(query)
+	if err != nil {
+		var aplErr *apl.Error
+		if errors.As(err, &aplErr) {
+			diags.AddAttributeError(path.Root("apl_query"), "Invalid APL Query", fmt.Sprintf("%s\n\n%s", aplErr, aplErr.Excerpt(query)))
+		} else {
+			diags.AddAttributeError(path.Root("apl_query"), "Invalid APL Query", err.Error())
 		}
-		if plan.RangeMinutes.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Range is required",
-				"Range is required for monitor type threshold",
-			))
+		return diags
+	}
+
+	for _, warning := range parsed.Warnings {
+		diags.AddAttributeWarning(path.Root("apl_query"), "APL Query Not Fully Validated", fmt.Sprintf("%s\n\n%s", warning, warning.Excerpt(query)))
+	}
+
+	if monitorType != axiom.MonitorTypeThreshold.String() || slices.ContainsFunc(parsed.Operators, apl.Operator.GroupsByTimeBin) {
+		return diags
+	}
+
+	// Point at the last summarize, which most likely lacks the time bin.
+	pos := parsed.Source.Pos
+	for _, op := range parsed.Operators {
+		if op.Name == "summarize" {
+			pos = op.Pos
 		}
-		if plan.Threshold.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Threshold is required",
-				"Threshold is required for monitor type threshold",
-			))
+	}
+	warning := &apl.Error{Pos: pos, Msg: "threshold monitors should summarize by a bin of _time, such as summarize count() by bin_auto(_time)"}
+	diags.AddAttributeWarning(path.Root("apl_query"), "APL Query Not Binned By Time", fmt.Sprintf("%s\n\n%s", warning, warning.Excerpt(query)))
+
+	return diags
+}
+
+// dryRunPlannedMonitorQuery runs the planned query of a new monitor, or of a
+// monitor whose query or type changed, against the query API. Unchanged
+// monitors are skipped so that planning doesn't query every dataset.
+func dryRunPlannedMonitorQuery(ctx context.Context, client *axiom.Client, state tfsdk.State, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var query, monitorType types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("apl_query"), &query)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if diags.HasError() || query.IsUnknown() || monitorType.IsUnknown() {
+		return diags
+	}
+
+	if !state.Raw.IsNull() {
+		var priorQuery, priorType types.String
+		diags.Append(state.GetAttribute(ctx, path.Root("apl_query"), &priorQuery)...)
+		diags.Append(state.GetAttribute(ctx, path.Root("type"), &priorType)...)
+		if diags.HasError() || query.Equal(priorQuery) && monitorType.Equal(priorType) {
+			return diags
 		}
-		if plan.Operator.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Operator is required",
-				"Operator is required for monitor type threshold",
-			))
+	}
+
+	diags.Append(dryRunMonitorQuery(ctx, client, query.ValueString(), monitorType.ValueString())...)
+	return diags
+}
+
+// limitMonitorQuery appends a limit of zero rows to the query of a monitor.
+// Trailing semicolons and whitespace are removed first, as a pipe can't follow
+// them, and the limit is continued on a new line, so that a trailing comment
+// doesn't swallow it.
+func limitMonitorQuery(query string) string {
+	query = strings.TrimRightFunc(query, func(r rune) bool {
+		return r == ';' || unicode.IsSpace(r)
+	})
+	return query + "\n| limit 0"
+}
+
+// dryRunMonitorQuery runs the query of a monitor over the last minute without
+// returning any rows, so that the query API reports unknown datasets and
+// fields. Threshold monitors must return a numeric column to compare with the
+// threshold. Errors that don't stem from the query only produce a warning.
+func dryRunMonitorQuery(ctx context.Context, client *axiom.Client, query string, monitorType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	end := time.Now()
+	result, err := client.Datasets.Query(ctx, limitMonitorQuery(query),
+		aplquery.SetStartTime(end.Add(-monitorDryRunRange)),
+		aplquery.SetEndTime(end),
+	)
+	if err != nil {
+		switch httpErrorStatus(err) {
+		case http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity:
+			diags.AddAttributeError(
+				path.Root("apl_query"),
+				"Invalid APL Query",
+				fmt.Sprintf("The query API rejected the query of the monitor: %s", err),
+			)
+		default:
+			diags.AddAttributeWarning(
+				path.Root("apl_query"),
+				"Unable To Validate APL Query",
+				fmt.Sprintf("The query of the monitor could not be run against the query API and was not validated: %s", err),
+			)
 		}
-	case axiom.MonitorTypeMatchEvent.String():
-
-	case axiom.MonitorTypeAnomalyDetection.String():
-		if plan.IntervalMinutes.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Interval is required",
-				"Interval is required for monitor type anomaly detection",
-			))
+		return diags
+	}
+
+	if monitorType != axiom.MonitorTypeThreshold.String() || len(result.Tables) == 0 {
+		return diags
+	}
+
+	var columns []string
+	for _, field := range result.Tables[0].Fields {
+		if field.Name != "_time" && isNumericFieldType(field.Type) {
+			return diags
 		}
-		if plan.RangeMinutes.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Range is required",
-				"Range is required for monitor type anomaly detection",
-			))
+		columns = append(columns, fmt.Sprintf("%s (%s)", field.Name, field.Type))
+	}
+
+	diags.AddAttributeError(
+		path.Root("apl_query"),
+		"APL Query Not Numeric",
+		fmt.Sprintf("Threshold monitors compare a numeric column of the query result with the threshold, but the query returns none. Columns: %s.", strings.Join(columns, ", ")),
+	)
+
+	return diags
+}
+
+// isNumericFieldType reports whether the type of a field of a query result,
+// which can be a composite type such as "integer|float", is numeric.
+func isNumericFieldType(fieldType string) bool {
+	for _, t := range strings.Split(fieldType, "|") {
+		switch strings.ToLower(strings.TrimSpace(t)) {
+		case "integer", "int", "long", "float", "real", "double", "decimal", "number":
+			return true
 		}
-		if plan.CompareDays.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"CompareDays is required",
-				"CompareDays is required for monitor type anomaly detection",
-			))
+	}
+	return false
+}
+
+// monitorDuration pairs a duration attribute of a monitor with the legacy
+// attribute it replaces, which holds a whole number of units. The unit is also
+// the granularity the API stores the duration with.
+type monitorDuration struct {
+	name      string
+	attribute string
+	legacy    string
+	unit      time.Duration
+	unitName  string
+	min       time.Duration
+	fallback  time.Duration
+}
+
+var monitorDurations = []monitorDuration{
+	{name: "Interval", attribute: "interval", legacy: "interval_minutes", unit: time.Minute, unitName: "minutes", min: time.Minute, fallback: time.Minute},
+	{name: "Range", attribute: "range", legacy: "range_minutes", unit: time.Minute, unitName: "minutes", min: time.Minute, fallback: time.Minute},
+	{name: "Delay", attribute: "delay_duration", legacy: "delay", unit: time.Second, unitName: "seconds"},
+}
+
+// resolveMonitorDurations returns the interval, range and delay of a monitor
+// keyed by their duration attribute, configured by either the duration or the
+// legacy attribute or else defaulted. Durations that aren't known until apply
+// are left out.
+func resolveMonitorDurations(ctx context.Context, config tfsdk.Config) (map[string]time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	durations := make(map[string]time.Duration, len(monitorDurations))
+	paths := make(map[string]path.Path, len(monitorDurations))
+	for _, d := range monitorDurations {
+		var value durationValue
+		var legacy types.Int64
+		diags.Append(config.GetAttribute(ctx, path.Root(d.attribute), &value)...)
+		diags.Append(config.GetAttribute(ctx, path.Root(d.legacy), &legacy)...)
+		if diags.HasError() {
+			return nil, diags
 		}
-		if plan.Tolerance.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Tolerance is required",
-				"Tolerance is required for monitor type anomaly detection",
-			))
+
+		duration, attributePath := d.fallback, path.Root(d.attribute)
+		switch {
+		case value.IsUnknown() || legacy.IsUnknown():
+			continue
+		case !value.IsNull():
+			var err error
+			if duration, err = value.ValueDuration(); err != nil {
+				// The duration type already reports invalid durations.
+				continue
+			}
+		case !legacy.IsNull():
+			duration, attributePath = time.Duration(legacy.ValueInt64())*d.unit, path.Root(d.legacy)
 		}
-		if plan.Operator.IsNull() {
-			diags = append(diags, diag.NewErrorDiagnostic(
-				"Operator is required",
-				"Operator is required for monitor type anomaly detection",
-			))
+
+		if duration%d.unit != 0 {
+			diags.AddAttributeError(
+				attributePath,
+				fmt.Sprintf("Invalid %s", d.name),
+				fmt.Sprintf("%s must be a whole number of %s, got %s.", d.name, d.unitName, formatDuration(duration)),
+			)
+			continue
 		}
+		if duration < d.min {
+			diags.AddAttributeError(
+				attributePath,
+				fmt.Sprintf("Invalid %s", d.name),
+				fmt.Sprintf("%s must be at least %s, got %s.", d.name, formatDuration(d.min), formatDuration(duration)),
+			)
+			continue
+		}
+
+		durations[d.attribute] = duration
+		paths[d.attribute] = attributePath
+	}
+
+	interval, hasInterval := durations["interval"]
+	monitorRange, hasRange := durations["range"]
+	if hasInterval && hasRange && monitorRange < interval {
+		diags.AddAttributeError(
+			paths["range"],
+			"Invalid Range",
+			fmt.Sprintf("Range must be at least the interval, got range %s and interval %s.", formatDuration(monitorRange), formatDuration(interval)),
+		)
+	}
+
+	return durations, diags
+}
+
+// planMonitorDurations plans both the duration and the legacy attribute of the
+// interval, range and delay of a monitor, so that either can be configured and
+// the other follows.
+func planMonitorDurations(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	durations, diags := resolveMonitorDurations(ctx, config)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, d := range monitorDurations {
+		var value durationValue
+		diags.Append(config.GetAttribute(ctx, path.Root(d.attribute), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		duration, ok := durations[d.attribute]
+		if !ok {
+			diags.Append(plan.SetAttribute(ctx, path.Root(d.legacy), types.Int64Unknown())...)
+			if value.IsNull() {
+				diags.Append(plan.SetAttribute(ctx, path.Root(d.attribute), durationValue{StringValue: types.StringUnknown()})...)
+			}
+			continue
+		}
+
+		// A configured duration is planned as written, semantic equality keeps
+		// it from drifting to the format the API returns.
+		if value.IsNull() {
+			diags.Append(plan.SetAttribute(ctx, path.Root(d.attribute), newDurationValue(duration))...)
+		}
+		diags.Append(plan.SetAttribute(ctx, path.Root(d.legacy), types.Int64Value(int64(duration/d.unit)))...)
 	}
+
 	return diags
 }
+
+// monitorDurationValue returns the duration of the duration attribute, or of
+// the legacy attribute in units if the duration attribute isn't set.
+func monitorDurationValue(value durationValue, legacy types.Int64, unit time.Duration) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Duration(legacy.ValueInt64()) * unit, nil
+	}
+
+	return value.ValueDuration()
+}
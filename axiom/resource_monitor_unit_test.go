@@ -0,0 +1,619 @@
+package axiom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	state := upgradeStateFromJSON(t, &MonitorResource{}, 0, `{
+		"id": "mon-1",
+		"name": "errors",
+		"description": null,
+		"alert_on_no_data": true,
+		"notify_by_group": null,
+		"apl_query": "['logs'] | where level == 'error' | summarize count() by bin_auto(_time)",
+		"disabled_until": null,
+		"interval_minutes": 5,
+		"notifier_ids": ["notifier-1"],
+		"operator": "Above",
+		"range_minutes": 10,
+		"threshold": 100,
+		"resolvable": null
+	}`)
+
+	var upgraded monitorResourceModelWithTimeouts
+	require.False(t, state.Get(context.Background(), &upgraded).HasError())
+
+	assert.Equal(t, "mon-1", upgraded.ID.ValueString())
+	assert.Equal(t, "Threshold", upgraded.Type.ValueString())
+	assert.True(t, upgraded.AlertOnNoData.ValueBool())
+	assert.False(t, upgraded.NotifyByGroup.IsNull())
+	assert.Equal(t, int64(5), upgraded.IntervalMinutes.ValueInt64())
+	assert.Equal(t, int64(10), upgraded.RangeMinutes.ValueInt64())
+	assert.Equal(t, "5m", upgraded.Interval.ValueString())
+	assert.Equal(t, "10m", upgraded.Range.ValueString())
+	assert.Equal(t, "0s", upgraded.DelayDuration.ValueString())
+	assert.Equal(t, "Above", upgraded.Operator.ValueString())
+	assert.InDelta(t, 100, upgraded.Threshold.ValueFloat64(), 0)
+	assert.Len(t, upgraded.NotifierIds.Elements(), 1)
+	assert.Equal(t, int64(1), upgraded.TriggerFromNRuns.ValueInt64())
+	assert.False(t, upgraded.Resolvable.ValueBool())
+	assert.True(t, upgraded.Description.IsNull())
+}
+
+// newMonitorTestConfig returns the configuration of a threshold monitor,
+// adjusted by configure.
+func newMonitorTestConfig(t *testing.T, configure func(m *MonitorResourceModel)) tfsdk.Config {
+	t.Helper()
+
+	model := MonitorResourceModel{
+		Name:        types.StringValue("errors"),
+		APLQuery:    types.StringValue("['logs'] | where level == 'error' | summarize count() by bin_auto(_time)"),
+		NotifierIds: types.ListNull(types.StringType),
+		Type:        types.StringValue("Threshold"),
+		Operator:    types.StringValue("Above"),
+		Threshold:   types.Float64Value(100),
+	}
+	configure(&model)
+
+	state := newTestState(t, &MonitorResource{}, monitorResourceModelWithTimeouts{
+		MonitorResourceModel: model,
+		Timeouts:             nullTimeouts(),
+	})
+
+	return tfsdk.Config{Raw: state.Raw, Schema: state.Schema}
+}
+
+func TestMonitorResourceDurations(t *testing.T) {
+	t.Parallel()
+
+	duration := func(s string) durationValue {
+		return durationValue{StringValue: types.StringValue(s)}
+	}
+
+	tests := []struct {
+		name          string
+		configure     func(m *MonitorResourceModel)
+		wantError     string
+		wantPath      path.Path
+		wantInterval  string
+		wantMinutes   int64
+		wantRange     string
+		wantRangeMins int64
+		wantDelay     string
+		wantDelaySecs int64
+	}{
+		{
+			name:          "defaults",
+			configure:     func(*MonitorResourceModel) {},
+			wantInterval:  "1m",
+			wantMinutes:   1,
+			wantRange:     "1m",
+			wantRangeMins: 1,
+			wantDelay:     "0s",
+		},
+		{
+			name: "legacy attributes",
+			configure: func(m *MonitorResourceModel) {
+				m.IntervalMinutes = types.Int64Value(5)
+				m.RangeMinutes = types.Int64Value(60)
+				m.Delay = types.Int64Value(90)
+			},
+			wantInterval:  "5m",
+			wantMinutes:   5,
+			wantRange:     "1h",
+			wantRangeMins: 60,
+			wantDelay:     "1m30s",
+			wantDelaySecs: 90,
+		},
+		{
+			name: "duration attributes",
+			configure: func(m *MonitorResourceModel) {
+				m.Interval = duration("PT5M")
+				m.Range = duration("1h")
+				m.DelayDuration = duration("PT30S")
+			},
+			wantInterval:  "PT5M",
+			wantMinutes:   5,
+			wantRange:     "1h",
+			wantRangeMins: 60,
+			wantDelay:     "PT30S",
+			wantDelaySecs: 30,
+		},
+		{
+			name: "sub-minute interval",
+			configure: func(m *MonitorResourceModel) {
+				m.Interval = duration("90s")
+				m.Range = duration("5m")
+			},
+			wantError: "Invalid Interval",
+			wantPath:  path.Root("interval"),
+		},
+		{
+			name: "sub-second delay",
+			configure: func(m *MonitorResourceModel) {
+				m.DelayDuration = duration("1500ms")
+			},
+			wantError: "Invalid Delay",
+			wantPath:  path.Root("delay_duration"),
+		},
+		{
+			name: "zero range",
+			configure: func(m *MonitorResourceModel) {
+				m.RangeMinutes = types.Int64Value(0)
+			},
+			wantError: "Invalid Range",
+			wantPath:  path.Root("range_minutes"),
+		},
+		{
+			name: "range shorter than interval",
+			configure: func(m *MonitorResourceModel) {
+				m.Interval = duration("10m")
+				m.Range = duration("5m")
+			},
+			wantError: "Invalid Range",
+			wantPath:  path.Root("range"),
+		},
+		{
+			name: "range defaulted shorter than interval",
+			configure: func(m *MonitorResourceModel) {
+				m.IntervalMinutes = types.Int64Value(5)
+			},
+			wantError: "Invalid Range",
+			wantPath:  path.Root("range"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := &MonitorResource{}
+			config := newMonitorTestConfig(t, tt.configure)
+
+			validateResp := resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &validateResp)
+			if tt.wantError != "" {
+				require.True(t, validateResp.Diagnostics.HasError())
+				err := validateResp.Diagnostics.Errors()[0]
+				assert.Equal(t, tt.wantError, err.Summary())
+				withPath, ok := err.(diag.DiagnosticWithPath)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantPath, withPath.Path())
+				return
+			}
+			require.False(t, validateResp.Diagnostics.HasError(), "%v", validateResp.Diagnostics)
+
+			plan := tfsdk.Plan{Raw: config.Raw, Schema: config.Schema}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan}, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var planned monitorResourceModelWithTimeouts
+			require.False(t, resp.Plan.Get(ctx, &planned).HasError())
+			assert.Equal(t, tt.wantInterval, planned.Interval.ValueString())
+			assert.Equal(t, tt.wantMinutes, planned.IntervalMinutes.ValueInt64())
+			assert.Equal(t, tt.wantRange, planned.Range.ValueString())
+			assert.Equal(t, tt.wantRangeMins, planned.RangeMinutes.ValueInt64())
+			assert.Equal(t, tt.wantDelay, planned.DelayDuration.ValueString())
+			assert.Equal(t, tt.wantDelaySecs, planned.Delay.ValueInt64())
+
+			monitor, diags := extractMonitorResourceModel(ctx, planned.MonitorResourceModel)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, time.Duration(tt.wantMinutes)*time.Minute, monitor.Interval)
+			assert.Equal(t, time.Duration(tt.wantRangeMins)*time.Minute, monitor.Range)
+			assert.Equal(t, time.Duration(tt.wantDelaySecs)*time.Second, monitor.Delay)
+		})
+	}
+}
+
+func TestMonitorResourceConfigValidators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		configure    func(m *MonitorResourceModel)
+		wantErrors   []path.Path
+		wantWarnings []path.Path
+	}{
+		{
+			name:      "threshold",
+			configure: func(*MonitorResourceModel) {},
+		},
+		{
+			name: "threshold without operator and threshold",
+			configure: func(m *MonitorResourceModel) {
+				m.Operator = types.StringNull()
+				m.Threshold = types.Float64Null()
+			},
+			wantErrors: []path.Path{path.Root("operator"), path.Root("threshold")},
+		},
+		{
+			name: "threshold with anomaly detection attributes",
+			configure: func(m *MonitorResourceModel) {
+				m.Tolerance = types.Float64Value(10)
+				m.CompareDays = types.Int64Value(7)
+				m.Resolvable = types.BoolValue(true)
+			},
+			wantWarnings: []path.Path{path.Root("tolerance"), path.Root("compare_days"), path.Root("resolvable")},
+		},
+		{
+			name: "threshold with unknown operator",
+			configure: func(m *MonitorResourceModel) {
+				m.Operator = types.StringUnknown()
+			},
+		},
+		{
+			name: "anomaly detection",
+			configure: func(m *MonitorResourceModel) {
+				m.Type = types.StringValue("AnomalyDetection")
+				m.Threshold = types.Float64Null()
+				m.CompareDays = types.Int64Value(7)
+				m.Tolerance = types.Float64Value(25)
+			},
+		},
+		{
+			name: "anomaly detection without compare days and tolerance",
+			configure: func(m *MonitorResourceModel) {
+				m.Type = types.StringValue("AnomalyDetection")
+			},
+			wantErrors:   []path.Path{path.Root("compare_days"), path.Root("tolerance")},
+			wantWarnings: []path.Path{path.Root("threshold")},
+		},
+		{
+			name: "match event",
+			configure: func(m *MonitorResourceModel) {
+				m.Type = types.StringValue("MatchEvent")
+				m.Operator = types.StringNull()
+				m.Threshold = types.Float64Null()
+				m.Resolvable = types.BoolValue(true)
+			},
+		},
+		{
+			name: "match event with threshold attributes",
+			configure: func(m *MonitorResourceModel) {
+				m.Type = types.StringValue("MatchEvent")
+			},
+			wantWarnings: []path.Path{path.Root("operator"), path.Root("threshold")},
+		},
+		{
+			name: "unknown type",
+			configure: func(m *MonitorResourceModel) {
+				m.Type = types.StringUnknown()
+				m.Operator = types.StringNull()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			config := newMonitorTestConfig(t, tt.configure)
+
+			var resp resource.ValidateConfigResponse
+			for _, v := range (&MonitorResource{}).ConfigValidators(ctx) {
+				v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			}
+
+			assert.Equal(t, tt.wantErrors, diagnosticPaths(resp.Diagnostics.Errors()))
+			assert.Equal(t, tt.wantWarnings, diagnosticPaths(resp.Diagnostics.Warnings()))
+		})
+	}
+}
+
+// diagnosticPaths returns the attribute paths of the diagnostics.
+func diagnosticPaths(diags diag.Diagnostics) []path.Path {
+	var paths []path.Path
+	for _, d := range diags {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			paths = append(paths, withPath.Path())
+		}
+	}
+
+	return paths
+}
+
+func TestValidateMonitorQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		monitorType string
+		wantError   string
+		wantWarning string
+	}{
+		{
+			name:        "threshold",
+			query:       "['logs'] | where level == 'error' | summarize count() by bin_auto(_time)",
+			monitorType: "Threshold",
+		},
+		{
+			name:        "threshold without time bin",
+			query:       "['logs']\n| summarize count()",
+			monitorType: "Threshold",
+			wantWarning: "line 2, column 3: threshold monitors should summarize by a bin of _time, such as summarize count() by bin_auto(_time)\n\n| summarize count()\n  ^",
+		},
+		{
+			name:        "match event without time bin",
+			query:       "['logs'] | where message contains 'panic'",
+			monitorType: "MatchEvent",
+		},
+		{
+			name:        "unknown operator",
+			query:       "['logs']\n| wher level == 'error'",
+			monitorType: "MatchEvent",
+			wantWarning: "line 2, column 3: unknown tabular operator wher, its arguments are not checked\n\n| wher level == 'error'\n  ^",
+		},
+		{
+			name:        "unquoted dataset",
+			query:       "logs | summarize count() by bin_auto(_time)",
+			monitorType: "Threshold",
+		},
+		{
+			name:        "unknown table function",
+			query:       "materialize(['logs']) | where level == 'error'",
+			monitorType: "MatchEvent",
+			wantWarning: "line 1, column 1: unknown table materialize, it is not checked\n\nmaterialize(['logs']) | where level == 'error'\n^",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateMonitorQuery(tt.query, tt.monitorType)
+
+			if tt.wantError == "" {
+				require.False(t, diags.HasError(), "%v", diags)
+			} else {
+				require.Len(t, diags.Errors(), 1)
+				assert.Equal(t, "Invalid APL Query", diags.Errors()[0].Summary())
+				assert.Equal(t, tt.wantError, diags.Errors()[0].Detail())
+				assert.Equal(t, []path.Path{path.Root("apl_query")}, diagnosticPaths(diags.Errors()))
+			}
+
+			if tt.wantWarning == "" {
+				assert.Empty(t, diags.Warnings())
+			} else {
+				require.Len(t, diags.Warnings(), 1)
+				assert.Equal(t, tt.wantWarning, diags.Warnings()[0].Detail())
+			}
+		})
+	}
+}
+
+func TestDryRunMonitorQuery(t *testing.T) {
+	t.Parallel()
+
+	// The responses leave out the query status, which axiom-go fails to decode
+	// with the JSON implementation of recent Go toolchains.
+	const numericResult = `{"tables":[{"name":"0","fields":[{"name":"_time","type":"datetime"},{"name":"count_","type":"integer"}],"columns":[]}]}`
+	const stringResult = `{"tables":[{"name":"0","fields":[{"name":"_time","type":"datetime"},{"name":"message","type":"string"}],"columns":[]}]}`
+
+	tests := []struct {
+		name        string
+		monitorType string
+		status      int
+		response    string
+		wantError   string
+		wantWarning string
+	}{
+		{
+			name:        "threshold",
+			monitorType: "Threshold",
+			status:      http.StatusOK,
+			response:    numericResult,
+		},
+		{
+			name:        "threshold without numeric column",
+			monitorType: "Threshold",
+			status:      http.StatusOK,
+			response:    stringResult,
+			wantError:   "APL Query Not Numeric",
+		},
+		{
+			name:        "match event",
+			monitorType: "MatchEvent",
+			status:      http.StatusOK,
+			response:    stringResult,
+		},
+		{
+			name:        "unknown dataset",
+			monitorType: "Threshold",
+			status:      http.StatusNotFound,
+			response:    `{"message":"dataset not found"}`,
+			wantError:   "Invalid APL Query",
+		},
+		{
+			name:        "unknown field",
+			monitorType: "Threshold",
+			status:      http.StatusBadRequest,
+			response:    `{"message":"unknown field 'levl'"}`,
+			wantError:   "Invalid APL Query",
+		},
+		{
+			name:        "server error",
+			monitorType: "Threshold",
+			status:      http.StatusInternalServerError,
+			response:    `{"message":"internal error"}`,
+			wantWarning: "Unable To Validate APL Query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/v1/datasets/_apl", r.URL.Path)
+
+				var body struct {
+					APL       string    `json:"apl"`
+					StartTime time.Time `json:"startTime"`
+					EndTime   time.Time `json:"endTime"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "['logs'] | summarize count() by bin_auto(_time)\n| limit 0", body.APL)
+				assert.Equal(t, monitorDryRunRange, body.EndTime.Sub(body.StartTime))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			t.Cleanup(srv.Close)
+
+			diags := dryRunMonitorQuery(context.Background(), newTestClient(t, srv.URL), "['logs'] | summarize count() by bin_auto(_time)", tt.monitorType)
+
+			if tt.wantError == "" {
+				require.False(t, diags.HasError(), "%v", diags)
+			} else {
+				require.Len(t, diags.Errors(), 1)
+				assert.Equal(t, tt.wantError, diags.Errors()[0].Summary())
+				assert.Equal(t, []path.Path{path.Root("apl_query")}, diagnosticPaths(diags.Errors()))
+			}
+
+			if tt.wantWarning == "" {
+				assert.Empty(t, diags.Warnings())
+			} else {
+				require.Len(t, diags.Warnings(), 1)
+				assert.Equal(t, tt.wantWarning, diags.Warnings()[0].Summary())
+			}
+		})
+	}
+}
+
+func TestDryRunMonitorQuery_TrailingSemicolon(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: "['logs'] | where level == 'error';",
+			want:  "['logs'] | where level == 'error'\n| limit 0",
+		},
+		{
+			query: "let level = 'error';\n['logs'] | where level == level ; \n",
+			want:  "let level = 'error';\n['logs'] | where level == level\n| limit 0",
+		},
+		{
+			query: "['logs'] | where level == 'error';; ",
+			want:  "['logs'] | where level == 'error'\n| limit 0",
+		},
+		{
+			query: "['logs'] // errors only",
+			want:  "['logs'] // errors only\n| limit 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					APL string `json:"apl"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.want, body.APL)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"tables":[]}`))
+			}))
+			t.Cleanup(srv.Close)
+
+			diags := dryRunMonitorQuery(context.Background(), newTestClient(t, srv.URL), tt.query, "MatchEvent")
+			assert.Empty(t, diags)
+		})
+	}
+}
+
+func TestMonitorResourceModifyPlan_ValidateQueries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		validateQueries bool
+		priorQuery      string
+		wantQueried     bool
+	}{
+		{
+			name:            "disabled",
+			validateQueries: false,
+		},
+		{
+			name:            "new monitor",
+			validateQueries: true,
+			wantQueried:     true,
+		},
+		{
+			name:            "changed query",
+			validateQueries: true,
+			priorQuery:      "['logs'] | summarize count() by bin_auto(_time)",
+			wantQueried:     true,
+		},
+		{
+			name:            "unchanged query",
+			validateQueries: true,
+			priorQuery:      "['logs'] | where level == 'error' | summarize count() by bin_auto(_time)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var queried atomic.Bool
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				queried.Store(true)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message":"dataset not found"}`))
+			}))
+			t.Cleanup(srv.Close)
+
+			ctx := context.Background()
+			r := &MonitorResource{client: newTestClient(t, srv.URL), validateQueries: tt.validateQueries}
+			config := newMonitorTestConfig(t, func(*MonitorResourceModel) {})
+
+			state := tfsdk.State{Raw: tftypes.NewValue(config.Schema.Type().TerraformType(ctx), nil), Schema: config.Schema}
+			if tt.priorQuery != "" {
+				prior := newMonitorTestConfig(t, func(m *MonitorResourceModel) {
+					m.ID = types.StringValue("mon-1")
+					m.APLQuery = types.StringValue(tt.priorQuery)
+				})
+				state = tfsdk.State{Raw: prior.Raw, Schema: prior.Schema}
+			}
+
+			plan := tfsdk.Plan{Raw: config.Raw, Schema: config.Schema}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: state}, &resp)
+
+			assert.Equal(t, tt.wantQueried, queried.Load())
+			assert.Equal(t, tt.wantQueried, resp.Diagnostics.HasError())
+		})
+	}
+}
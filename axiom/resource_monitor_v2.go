@@ -0,0 +1,544 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/axiomhq/axiom-go/axiom"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &MonitorV2Resource{}
+	_ resource.ResourceWithImportState      = &MonitorV2Resource{}
+	_ resource.ResourceWithConfigValidators = &MonitorV2Resource{}
+	_ resource.ResourceWithValidateConfig   = &MonitorV2Resource{}
+	_ resource.ResourceWithModifyPlan       = &MonitorV2Resource{}
+	_ resource.ResourceWithMoveState        = &MonitorV2Resource{}
+)
+
+// monitorTypeAttributes are the attributes of axiom_monitor that only apply
+// to some monitor types. axiom_monitor_v2 configures them in the block of the
+// monitor type instead.
+var monitorTypeAttributes = []string{"type", "operator", "threshold", "tolerance", "compare_days", "resolvable"}
+
+func NewMonitorV2Resource() resource.Resource {
+	return &MonitorV2Resource{}
+}
+
+// MonitorV2Resource defines the resource implementation. It manages the same
+// monitors as MonitorResource, but configures the settings of each monitor
+// type in a block of its own.
+type MonitorV2Resource struct {
+	client          *axiom.Client
+	validateQueries bool
+}
+
+// MonitorV2ResourceModel describes the resource data model.
+type MonitorV2ResourceModel struct {
+	Name                         types.String                  `tfsdk:"name"`
+	Description                  types.String                  `tfsdk:"description"`
+	ID                           types.String                  `tfsdk:"id"`
+	AlertOnNoData                types.Bool                    `tfsdk:"alert_on_no_data"`
+	NotifyByGroup                types.Bool                    `tfsdk:"notify_by_group"`
+	APLQuery                     types.String                  `tfsdk:"apl_query"`
+	DisabledUntil                types.String                  `tfsdk:"disabled_until"`
+	IntervalMinutes              types.Int64                   `tfsdk:"interval_minutes"`
+	Interval                     durationValue                 `tfsdk:"interval"`
+	NotifierIds                  types.List                    `tfsdk:"notifier_ids"`
+	RangeMinutes                 types.Int64                   `tfsdk:"range_minutes"`
+	Range                        durationValue                 `tfsdk:"range"`
+	Delay                        types.Int64                   `tfsdk:"delay"`
+	DelayDuration                durationValue                 `tfsdk:"delay_duration"`
+	NotifyEveryRun               types.Bool                    `tfsdk:"notify_every_run"`
+	SkipResolved                 types.Bool                    `tfsdk:"skip_resolved"`
+	TriggerFromNRuns             types.Int64                   `tfsdk:"trigger_from_n_runs"`
+	TriggerAfterNPositiveResults types.Int64                   `tfsdk:"trigger_after_n_positive_results"`
+	Type                         types.String                  `tfsdk:"type"`
+	CreatedBy                    types.String                  `tfsdk:"created_by"`
+	CreatedAt                    types.String                  `tfsdk:"created_at"`
+	Threshold                    *monitorThresholdModel        `tfsdk:"threshold"`
+	AnomalyDetection             *monitorAnomalyDetectionModel `tfsdk:"anomaly_detection"`
+	MatchEvent                   *monitorMatchEventModel       `tfsdk:"match_event"`
+	Timeouts                     timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// monitorThresholdModel describes the threshold block.
+type monitorThresholdModel struct {
+	Operator  types.String  `tfsdk:"operator"`
+	Threshold types.Float64 `tfsdk:"threshold"`
+}
+
+// monitorAnomalyDetectionModel describes the anomaly_detection block.
+type monitorAnomalyDetectionModel struct {
+	Operator    types.String  `tfsdk:"operator"`
+	CompareDays types.Int64   `tfsdk:"compare_days"`
+	Tolerance   types.Float64 `tfsdk:"tolerance"`
+	Resolvable  types.Bool    `tfsdk:"resolvable"`
+}
+
+// monitorMatchEventModel describes the match_event block.
+type monitorMatchEventModel struct {
+	Resolvable types.Bool `tfsdk:"resolvable"`
+}
+
+func (r *MonitorV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_v2"
+}
+
+func (r *MonitorV2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The attributes shared by every monitor type are described the same way
+	// as by axiom_monitor.
+	var flat MonitorResource
+	var flatResp resource.SchemaResponse
+	flat.Schema(ctx, resource.SchemaRequest{}, &flatResp)
+
+	attributes := flatResp.Schema.Attributes
+	for _, name := range monitorTypeAttributes {
+		delete(attributes, name)
+	}
+	attributes["type"] = schema.StringAttribute{
+		MarkdownDescription: "The type of the monitor, given by the configured block: 'Threshold', 'AnomalyDetection' or 'MatchEvent'",
+		Computed:            true,
+	}
+
+	operatorValidator := stringvalidator.OneOf([]string{
+		axiom.Below.String(),
+		axiom.BelowOrEqual.String(),
+		axiom.Above.String(),
+		axiom.AboveOrEqual.String(),
+	}...)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a monitor. Unlike `axiom_monitor`, the settings of each monitor type are configured in a block of " +
+			"their own, exactly one of `threshold`, `anomaly_detection` and `match_event`. " +
+			"Existing `axiom_monitor` resources can be moved to `axiom_monitor_v2` with a `moved` block without recreating the monitor.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"threshold": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures a threshold monitor, which triggers when the query result crosses the threshold",
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator used to compare the query result with the threshold",
+						Required:            true,
+						Validators:          []validator.String{operatorValidator},
+					},
+					"threshold": schema.Float64Attribute{
+						MarkdownDescription: "The threshold where the monitor should trigger",
+						Required:            true,
+					},
+				},
+			},
+			"anomaly_detection": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures an anomaly detection monitor, which triggers when the query result deviates from the results of previous days",
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator used to compare the query result with the expected result",
+						Required:            true,
+						Validators:          []validator.String{operatorValidator},
+					},
+					"compare_days": schema.Int64Attribute{
+						MarkdownDescription: "The number of days to compare with",
+						Required:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"tolerance": schema.Float64Attribute{
+						MarkdownDescription: "The tolerance percentage of the deviation",
+						Required:            true,
+					},
+					"resolvable": schema.BoolAttribute{
+						MarkdownDescription: "Determines whether the events triggered by the monitor are individually resolvable",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"match_event": schema.SingleNestedBlock{
+				MarkdownDescription: "Configures a match event monitor, which triggers for every event the query returns",
+				Attributes: map[string]schema.Attribute{
+					"resolvable": schema.BoolAttribute{
+						MarkdownDescription: "Determines whether the events triggered by the monitor are individually resolvable",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *MonitorV2Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("threshold"),
+			path.MatchRoot("anomaly_detection"),
+			path.MatchRoot("match_event"),
+		),
+	}
+}
+
+func (r *MonitorV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	_, diags := resolveMonitorDurations(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	var config MonitorV2ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.APLQuery.IsNull() || config.APLQuery.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMonitorQuery(config.APLQuery.ValueString(), config.monitorType())...)
+}
+
+func (r *MonitorV2Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = data.client
+	r.validateQueries = data.validateQueries
+}
+
+func (r *MonitorV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan MonitorV2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The type follows from the configured block, so it is known at plan time.
+	if monitorType := plan.monitorType(); monitorType != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"), monitorType)...)
+	}
+
+	resp.Diagnostics.Append(planMonitorDurations(ctx, req.Config, &resp.Plan)...)
+	if resp.Diagnostics.HasError() || !r.validateQueries || r.client == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(dryRunPlannedMonitorQuery(ctx, r.client, req.State, resp.Plan)...)
+}
+
+func (r *MonitorV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MonitorV2ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "monitor", resourceAddress("axiom_monitor_v2", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "Client is not set")
+		return
+	}
+
+	monitor, diags := extractMonitorResourceModel(ctx, plan.flatten())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	monitor, err := r.client.Monitors.Create(ctx, axiom.MonitorCreateRequest{Monitor: *monitor})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Monitor, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorV2FromFlat(flattenMonitor(monitor), plan.Timeouts))...)
+}
+
+func (r *MonitorV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var plan MonitorV2ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "monitor", resourceAddress("axiom_monitor_v2", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, err := r.client.Monitors.Get(ctx, plan.ID.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddWarning(
+				"Monitor Not Found",
+				fmt.Sprintf("Monitor with ID %s does not exist and will be recreated if still defined in the configuration.", plan.ID.ValueString()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Unable to read Monitor", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorV2FromFlat(flattenMonitor(monitor), plan.Timeouts))...)
+}
+
+func (r *MonitorV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan MonitorV2ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "monitor", resourceAddress("axiom_monitor_v2", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitor, diags := extractMonitorResourceModel(ctx, plan.flatten())
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	monitor, err := r.client.Monitors.Update(ctx, plan.ID.ValueString(), axiom.MonitorUpdateRequest{Monitor: *monitor})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update Monitor", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, monitorV2FromFlat(flattenMonitor(monitor), plan.Timeouts))...)
+}
+
+func (r *MonitorV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var plan MonitorV2ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "monitor", resourceAddress("axiom_monitor_v2", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Monitors.Delete(ctx, plan.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Monitor", err.Error())
+		return
+	}
+}
+
+func (r *MonitorV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MonitorV2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	var source MonitorResource
+	var sourceResp resource.SchemaResponse
+	source.Schema(ctx, resource.SchemaRequest{}, &sourceResp)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: source.UpgradeState(ctx)[0].PriorSchema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				moveMonitorStateV0(ctx, sourceResp.Schema, req, resp)
+			},
+		},
+		{
+			SourceSchema: &sourceResp.Schema,
+			StateMover:   moveMonitorState,
+		},
+	}
+}
+
+// moveMonitorStateV0 moves version 0 of the axiom_monitor state to
+// axiom_monitor_v2 by upgrading it to version 1 first, the same way
+// axiom_monitor does. Other versions are left to moveMonitorState.
+func moveMonitorStateV0(ctx context.Context, sourceSchema schema.Schema, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "axiom_monitor" || req.SourceSchemaVersion != 0 || req.SourceState == nil {
+		return
+	}
+
+	upgradeResp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: sourceSchema,
+			Raw:    tftypes.NewValue(sourceSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgradeMonitorStateV0(ctx, resource.UpgradeStateRequest{State: req.SourceState}, &upgradeResp)
+	resp.Diagnostics.Append(upgradeResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moveMonitorState(ctx, resource.MoveStateRequest{
+		SourceTypeName:      req.SourceTypeName,
+		SourceSchemaVersion: 1,
+		SourceState:         &upgradeResp.State,
+	}, resp)
+}
+
+// moveMonitorState moves version 1 of the axiom_monitor state to
+// axiom_monitor_v2.
+func moveMonitorState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "axiom_monitor" || req.SourceSchemaVersion == 0 {
+		return
+	}
+
+	if req.SourceSchemaVersion != 1 || req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unsupported Monitor State",
+			fmt.Sprintf("Only versions 0 and 1 of the axiom_monitor state can be moved to axiom_monitor_v2, got version %d.", req.SourceSchemaVersion),
+		)
+		return
+	}
+
+	var source monitorResourceModelWithTimeouts
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, monitorV2FromFlat(source.MonitorResourceModel, source.Timeouts))...)
+}
+
+// monitorType returns the type of the monitor given by the configured block,
+// or an empty string if none is configured.
+func (m MonitorV2ResourceModel) monitorType() string {
+	switch {
+	case m.Threshold != nil:
+		return axiom.MonitorTypeThreshold.String()
+	case m.AnomalyDetection != nil:
+		return axiom.MonitorTypeAnomalyDetection.String()
+	case m.MatchEvent != nil:
+		return axiom.MonitorTypeMatchEvent.String()
+	default:
+		return ""
+	}
+}
+
+// flatten returns the flat monitor model, with the attributes that don't
+// apply to the monitor type set to their axiom_monitor defaults.
+func (m MonitorV2ResourceModel) flatten() MonitorResourceModel {
+	flat := MonitorResourceModel{
+		Name:                         m.Name,
+		Description:                  m.Description,
+		ID:                           m.ID,
+		AlertOnNoData:                m.AlertOnNoData,
+		NotifyByGroup:                m.NotifyByGroup,
+		APLQuery:                     m.APLQuery,
+		DisabledUntil:                m.DisabledUntil,
+		IntervalMinutes:              m.IntervalMinutes,
+		Interval:                     m.Interval,
+		NotifierIds:                  m.NotifierIds,
+		Operator:                     types.StringValue(""),
+		RangeMinutes:                 m.RangeMinutes,
+		Range:                        m.Range,
+		Threshold:                    types.Float64Value(0),
+		Resolvable:                   types.BoolValue(false),
+		Delay:                        m.Delay,
+		DelayDuration:                m.DelayDuration,
+		NotifyEveryRun:               m.NotifyEveryRun,
+		SkipResolved:                 m.SkipResolved,
+		Tolerance:                    types.Float64Value(0),
+		TriggerFromNRuns:             m.TriggerFromNRuns,
+		TriggerAfterNPositiveResults: m.TriggerAfterNPositiveResults,
+		CompareDays:                  types.Int64Value(0),
+		Type:                         types.StringValue(m.monitorType()),
+		CreatedBy:                    m.CreatedBy,
+		CreatedAt:                    m.CreatedAt,
+	}
+
+	switch {
+	case m.Threshold != nil:
+		flat.Operator = m.Threshold.Operator
+		flat.Threshold = m.Threshold.Threshold
+	case m.AnomalyDetection != nil:
+		flat.Operator = m.AnomalyDetection.Operator
+		flat.CompareDays = m.AnomalyDetection.CompareDays
+		flat.Tolerance = m.AnomalyDetection.Tolerance
+		flat.Resolvable = m.AnomalyDetection.Resolvable
+	case m.MatchEvent != nil:
+		flat.Resolvable = m.MatchEvent.Resolvable
+	}
+
+	return flat
+}
+
+// monitorV2FromFlat returns the axiom_monitor_v2 model of the flat monitor
+// model, keeping only the attributes that apply to the monitor type.
+func monitorV2FromFlat(flat MonitorResourceModel, timeoutsValue timeouts.Value) MonitorV2ResourceModel {
+	m := MonitorV2ResourceModel{
+		Name:                         flat.Name,
+		Description:                  flat.Description,
+		ID:                           flat.ID,
+		AlertOnNoData:                flat.AlertOnNoData,
+		NotifyByGroup:                flat.NotifyByGroup,
+		APLQuery:                     flat.APLQuery,
+		DisabledUntil:                flat.DisabledUntil,
+		IntervalMinutes:              flat.IntervalMinutes,
+		Interval:                     flat.Interval,
+		NotifierIds:                  flat.NotifierIds,
+		RangeMinutes:                 flat.RangeMinutes,
+		Range:                        flat.Range,
+		Delay:                        flat.Delay,
+		DelayDuration:                flat.DelayDuration,
+		NotifyEveryRun:               flat.NotifyEveryRun,
+		SkipResolved:                 flat.SkipResolved,
+		TriggerFromNRuns:             flat.TriggerFromNRuns,
+		TriggerAfterNPositiveResults: flat.TriggerAfterNPositiveResults,
+		Type:                         flat.Type,
+		CreatedBy:                    flat.CreatedBy,
+		CreatedAt:                    flat.CreatedAt,
+		Timeouts:                     timeoutsValue,
+	}
+
+	switch flat.Type.ValueString() {
+	case axiom.MonitorTypeThreshold.String():
+		m.Threshold = &monitorThresholdModel{
+			Operator:  flat.Operator,
+			Threshold: flat.Threshold,
+		}
+	case axiom.MonitorTypeAnomalyDetection.String():
+		m.AnomalyDetection = &monitorAnomalyDetectionModel{
+			Operator:    flat.Operator,
+			CompareDays: flat.CompareDays,
+			Tolerance:   flat.Tolerance,
+			Resolvable:  boolOrDefault(flat.Resolvable, false),
+		}
+	case axiom.MonitorTypeMatchEvent.String():
+		m.MatchEvent = &monitorMatchEventModel{
+			Resolvable: boolOrDefault(flat.Resolvable, false),
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,208 @@
+package axiom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// moveMonitorStateFromJSON moves recorded axiom_monitor state JSON of the
+// given schema version to axiom_monitor_v2, the same way Terraform does: each
+// state mover is called in turn until one of them fails or sets the state.
+func moveMonitorStateFromJSON(t *testing.T, version int64, rawState string) (tfsdk.State, resource.MoveStateResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	r := &MonitorV2Resource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	nullState := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	var resp resource.MoveStateResponse
+	for _, mover := range r.MoveState(ctx) {
+		require.NotNil(t, mover.SourceSchema)
+
+		source, err := (&tfprotov6.RawState{JSON: []byte(rawState)}).UnmarshalWithOpts(
+			mover.SourceSchema.Type().TerraformType(ctx),
+			tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+		)
+		require.NoError(t, err)
+
+		req := resource.MoveStateRequest{
+			SourceTypeName:      "axiom_monitor",
+			SourceSchemaVersion: version,
+			SourceState:         &tfsdk.State{Raw: source, Schema: *mover.SourceSchema},
+		}
+		resp = resource.MoveStateResponse{
+			TargetState: tfsdk.State{Raw: nullState, Schema: schemaResp.Schema},
+		}
+
+		mover.StateMover(ctx, req, &resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.Equal(nullState) {
+			break
+		}
+	}
+
+	return resp.TargetState, resp
+}
+
+func TestMonitorV2ResourceMoveState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		state string
+		check func(t *testing.T, moved MonitorV2ResourceModel)
+	}{
+		{
+			name: "threshold",
+			state: `{
+				"id": "mon-1",
+				"name": "errors",
+				"apl_query": "['logs'] | where level == 'error' | summarize count() by bin_auto(_time)",
+				"interval_minutes": 5,
+				"range_minutes": 10,
+				"notifier_ids": ["notifier-1"],
+				"type": "Threshold",
+				"operator": "Above",
+				"threshold": 100,
+				"tolerance": 0,
+				"compare_days": 0,
+				"resolvable": false,
+				"trigger_from_n_runs": 1
+			}`,
+			check: func(t *testing.T, moved MonitorV2ResourceModel) {
+				require.NotNil(t, moved.Threshold)
+				assert.Nil(t, moved.AnomalyDetection)
+				assert.Nil(t, moved.MatchEvent)
+				assert.Equal(t, "Above", moved.Threshold.Operator.ValueString())
+				assert.InDelta(t, 100, moved.Threshold.Threshold.ValueFloat64(), 0)
+				assert.Equal(t, int64(5), moved.IntervalMinutes.ValueInt64())
+				assert.Len(t, moved.NotifierIds.Elements(), 1)
+			},
+		},
+		{
+			name: "anomaly detection",
+			state: `{
+				"id": "mon-2",
+				"name": "latency",
+				"apl_query": "['logs'] | summarize avg(duration) by bin_auto(_time)",
+				"type": "AnomalyDetection",
+				"operator": "AboveOrEqual",
+				"threshold": 0,
+				"tolerance": 25,
+				"compare_days": 7,
+				"resolvable": true
+			}`,
+			check: func(t *testing.T, moved MonitorV2ResourceModel) {
+				require.NotNil(t, moved.AnomalyDetection)
+				assert.Nil(t, moved.Threshold)
+				assert.Equal(t, "AboveOrEqual", moved.AnomalyDetection.Operator.ValueString())
+				assert.Equal(t, int64(7), moved.AnomalyDetection.CompareDays.ValueInt64())
+				assert.InDelta(t, 25, moved.AnomalyDetection.Tolerance.ValueFloat64(), 0)
+				assert.True(t, moved.AnomalyDetection.Resolvable.ValueBool())
+			},
+		},
+		{
+			name: "match event",
+			state: `{
+				"id": "mon-3",
+				"name": "panics",
+				"apl_query": "['logs'] | where message contains 'panic'",
+				"type": "MatchEvent",
+				"operator": "",
+				"resolvable": null
+			}`,
+			check: func(t *testing.T, moved MonitorV2ResourceModel) {
+				require.NotNil(t, moved.MatchEvent)
+				assert.Nil(t, moved.Threshold)
+				assert.Nil(t, moved.AnomalyDetection)
+				assert.False(t, moved.MatchEvent.Resolvable.ValueBool())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state, resp := moveMonitorStateFromJSON(t, 1, tt.state)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var moved MonitorV2ResourceModel
+			require.False(t, state.Get(context.Background(), &moved).HasError())
+			tt.check(t, moved)
+		})
+	}
+}
+
+func TestMonitorV2ResourceMoveState_V0(t *testing.T) {
+	t.Parallel()
+
+	state, resp := moveMonitorStateFromJSON(t, 0, `{
+		"id": "mon-1",
+		"name": "errors",
+		"apl_query": "['logs'] | where level == 'error' | summarize count() by bin_auto(_time)",
+		"interval_minutes": 5,
+		"range_minutes": 10,
+		"notifier_ids": ["notifier-1"],
+		"operator": "Above",
+		"threshold": 100
+	}`)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var moved MonitorV2ResourceModel
+	require.False(t, state.Get(context.Background(), &moved).HasError())
+	require.NotNil(t, moved.Threshold)
+	assert.Equal(t, "mon-1", moved.ID.ValueString())
+	assert.Equal(t, "Above", moved.Threshold.Operator.ValueString())
+	assert.InDelta(t, 100, moved.Threshold.Threshold.ValueFloat64(), 0)
+	assert.Equal(t, int64(10), moved.RangeMinutes.ValueInt64())
+	assert.Len(t, moved.NotifierIds.Elements(), 1)
+}
+
+func TestMonitorV2ResourceMoveState_UnsupportedVersion(t *testing.T) {
+	t.Parallel()
+
+	_, resp := moveMonitorStateFromJSON(t, 2, `{"id": "mon-1", "name": "errors"}`)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Unsupported Monitor State", resp.Diagnostics.Errors()[0].Summary())
+}
+
+func TestMonitorV2ResourceModelFlatten(t *testing.T) {
+	t.Parallel()
+
+	model := MonitorV2ResourceModel{
+		Name:     types.StringValue("latency"),
+		APLQuery: types.StringValue("['logs'] | summarize avg(duration) by bin_auto(_time)"),
+		AnomalyDetection: &monitorAnomalyDetectionModel{
+			Operator:    types.StringValue("Above"),
+			CompareDays: types.Int64Value(7),
+			Tolerance:   types.Float64Value(25),
+			Resolvable:  types.BoolValue(true),
+		},
+	}
+
+	flat := model.flatten()
+	assert.Equal(t, "AnomalyDetection", flat.Type.ValueString())
+	assert.Equal(t, "Above", flat.Operator.ValueString())
+	assert.Equal(t, int64(7), flat.CompareDays.ValueInt64())
+	assert.InDelta(t, 25, flat.Tolerance.ValueFloat64(), 0)
+	assert.InDelta(t, 0, flat.Threshold.ValueFloat64(), 0)
+	assert.True(t, flat.Resolvable.ValueBool())
+
+	roundTrip := monitorV2FromFlat(flat, model.Timeouts)
+	assert.Equal(t, model.AnomalyDetection, roundTrip.AnomalyDetection)
+	assert.Nil(t, roundTrip.Threshold)
+	assert.Nil(t, roundTrip.MatchEvent)
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -35,9 +38,17 @@ type NotifierResource struct {
 
 // NotifierResourceModel describes the resource data model.
 type NotifierResourceModel struct {
-	ID         types.String        `tfsdk:"id"`
-	Name       types.String        `tfsdk:"name"`
-	Properties *NotifierProperties `tfsdk:"properties"`
+	ID            types.String        `tfsdk:"id"`
+	Name          types.String        `tfsdk:"name"`
+	SecretVersion types.Int64         `tfsdk:"secret_version"`
+	Properties    *NotifierProperties `tfsdk:"properties"`
+}
+
+// notifierResourceModelWithTimeouts adds the timeouts block, which only the
+// managed resource exposes, to the notifier model shared with the data source.
+type notifierResourceModelWithTimeouts struct {
+	NotifierResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type NotifierProperties struct {
@@ -52,16 +63,19 @@ type NotifierProperties struct {
 }
 
 type SlackConfig struct {
-	SlackURL types.String `tfsdk:"slack_url"`
+	SlackURL   types.String `tfsdk:"slack_url"`
+	SlackURLWO types.String `tfsdk:"slack_url_wo"`
 }
 
 type DiscordConfig struct {
 	DiscordChannel types.String `tfsdk:"discord_channel"`
 	DiscordToken   types.String `tfsdk:"discord_token"`
+	DiscordTokenWO types.String `tfsdk:"discord_token_wo"`
 }
 
 type DiscordWebhookConfig struct {
-	DiscordWebhookURL types.String `tfsdk:"discord_webhook_url"`
+	DiscordWebhookURL   types.String `tfsdk:"discord_webhook_url"`
+	DiscordWebhookURLWO types.String `tfsdk:"discord_webhook_url_wo"`
 }
 
 type EmailConfig struct {
@@ -69,13 +83,15 @@ type EmailConfig struct {
 }
 
 type OpsGenieConfig struct {
-	APIKey types.String `tfsdk:"api_key"`
-	IsEU   types.Bool   `tfsdk:"is_eu"`
+	APIKey   types.String `tfsdk:"api_key"`
+	APIKeyWO types.String `tfsdk:"api_key_wo"`
+	IsEU     types.Bool   `tfsdk:"is_eu"`
 }
 
 type PagerDutyConfig struct {
-	RoutingKey types.String `tfsdk:"routing_key"`
-	Token      types.String `tfsdk:"token"`
+	RoutingKey   types.String `tfsdk:"routing_key"`
+	RoutingKeyWO types.String `tfsdk:"routing_key_wo"`
+	Token        types.String `tfsdk:"token"`
 }
 
 type WebhookConfig struct {
@@ -83,16 +99,17 @@ type WebhookConfig struct {
 }
 
 type CustomWebhookConfig struct {
-	URL     types.String `tfsdk:"url"`
-	Headers types.Map    `tfsdk:"headers"`
-	Body    types.String `tfsdk:"body"`
+	URL       types.String `tfsdk:"url"`
+	Headers   types.Map    `tfsdk:"headers"`
+	HeadersWO types.Map    `tfsdk:"headers_wo"`
+	Body      types.String `tfsdk:"body"`
 }
 
 func (r *NotifierResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_notifier"
 }
 
-func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotifierResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
@@ -107,6 +124,10 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				MarkdownDescription: "Notifier name",
 				Required:            true,
 			},
+			"secret_version": schema.Int64Attribute{
+				MarkdownDescription: "Change this value to send the write-only secrets of the notifier to Axiom again, for example after rotating them",
+				Optional:            true,
+			},
 			"properties": schema.SingleNestedAttribute{
 				MarkdownDescription: "The properties of the notifier",
 				Required:            true,
@@ -114,8 +135,17 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"slack": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
 							"slack_url": schema.StringAttribute{
-								MarkdownDescription: "The slack URL",
-								Required:            true,
+								MarkdownDescription: "The slack URL. Exactly one of slack_url or slack_url_wo must be set",
+								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("slack_url_wo")),
+								},
+							},
+							"slack_url_wo": schema.StringAttribute{
+								MarkdownDescription: "Write-only variant of slack_url. This value is never stored in the state; change secret_version to update it",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
 							},
 						},
 						Optional: true,
@@ -138,8 +168,17 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 								Required:            true,
 							},
 							"discord_token": schema.StringAttribute{
-								MarkdownDescription: "The discord token",
-								Required:            true,
+								MarkdownDescription: "The discord token. Exactly one of discord_token or discord_token_wo must be set",
+								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("discord_token_wo")),
+								},
+							},
+							"discord_token_wo": schema.StringAttribute{
+								MarkdownDescription: "Write-only variant of discord_token. This value is never stored in the state; change secret_version to update it",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
 							},
 						},
 						Optional: true,
@@ -158,8 +197,17 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"discord_webhook": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
 							"discord_webhook_url": schema.StringAttribute{
-								MarkdownDescription: "The discord webhook URL",
-								Required:            true,
+								MarkdownDescription: "The discord webhook URL. Exactly one of discord_webhook_url or discord_webhook_url_wo must be set",
+								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("discord_webhook_url_wo")),
+								},
+							},
+							"discord_webhook_url_wo": schema.StringAttribute{
+								MarkdownDescription: "Write-only variant of discord_webhook_url. This value is never stored in the state; change secret_version to update it",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
 							},
 						},
 						Optional: true,
@@ -199,8 +247,17 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"opsgenie": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
 							"api_key": schema.StringAttribute{
-								MarkdownDescription: "The opsgenie API key",
-								Required:            true,
+								MarkdownDescription: "The opsgenie API key. Exactly one of api_key or api_key_wo must be set",
+								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("api_key_wo")),
+								},
+							},
+							"api_key_wo": schema.StringAttribute{
+								MarkdownDescription: "Write-only variant of api_key. This value is never stored in the state; change secret_version to update it",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
 							},
 							"is_eu": schema.BoolAttribute{
 								MarkdownDescription: "The opsgenie is EU",
@@ -223,8 +280,17 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"pagerduty": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
 							"routing_key": schema.StringAttribute{
-								MarkdownDescription: "The pagerduty routing key",
-								Required:            true,
+								MarkdownDescription: "The pagerduty routing key. Exactly one of routing_key or routing_key_wo must be set",
+								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("routing_key_wo")),
+								},
+							},
+							"routing_key_wo": schema.StringAttribute{
+								MarkdownDescription: "Write-only variant of routing_key. This value is never stored in the state; change secret_version to update it",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
 							},
 							"token": schema.StringAttribute{
 								MarkdownDescription: "The pager duty token",
@@ -280,6 +346,16 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 								MarkdownDescription: "Any headers associated with the request",
 								Optional:            true,
 								Sensitive:           true,
+								Validators: []validator.Map{
+									mapvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("headers_wo")),
+								},
+							},
+							"headers_wo": schema.MapAttribute{
+								ElementType:         types.StringType,
+								MarkdownDescription: "Write-only variant of headers. This value is never stored in the state; change secret_version to update it",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
 							},
 						},
 						Optional: true,
@@ -298,33 +374,33 @@ func (r *NotifierResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
 func (r *NotifierResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*axiom.Client)
-
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *NotifierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan NotifierResourceModel
+	var plan notifierResourceModelWithTimeouts
+	var config notifierResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "notifier", resourceAddress("axiom_notifier", plan.ID), &resp.Diagnostics)
+	defer done()
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -334,7 +410,7 @@ func (r *NotifierResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	notifier, diags := extractNotifier(ctx, plan)
+	notifier, diags := extractNotifier(ctx, withWriteOnlySecrets(plan.NotifierResourceModel, config.NotifierResourceModel))
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -346,11 +422,14 @@ func (r *NotifierResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, mergeNotifierState(flattenNotifier(*notifier), plan))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notifierResourceModelWithTimeouts{
+		NotifierResourceModel: mergeNotifierState(flattenNotifier(*notifier), plan.NotifierResourceModel),
+		Timeouts:              plan.Timeouts,
+	})...)
 }
 
 func (r *NotifierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan NotifierResourceModel
+	var plan notifierResourceModelWithTimeouts
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
@@ -359,6 +438,12 @@ func (r *NotifierResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "notifier", resourceAddress("axiom_notifier", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	notifier, err := r.client.Notifiers.Get(ctx, plan.ID.ValueString())
 	if err != nil {
 		if isNotFoundError(err) {
@@ -373,19 +458,31 @@ func (r *NotifierResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, mergeNotifierState(flattenNotifier(*notifier), plan))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notifierResourceModelWithTimeouts{
+		NotifierResourceModel: mergeNotifierState(flattenNotifier(*notifier), plan.NotifierResourceModel),
+		Timeouts:              plan.Timeouts,
+	})...)
 }
 
 func (r *NotifierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan NotifierResourceModel
+	var plan notifierResourceModelWithTimeouts
+	var config notifierResourceModelWithTimeouts
 	// Read Terraform plan plan into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Write-only secrets are only available in the configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	notifier, diags := extractNotifier(ctx, plan)
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "notifier", resourceAddress("axiom_notifier", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notifier, diags := extractNotifier(ctx, withWriteOnlySecrets(plan.NotifierResourceModel, config.NotifierResourceModel))
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -397,11 +494,14 @@ func (r *NotifierResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, mergeNotifierState(flattenNotifier(*notifier), plan))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, notifierResourceModelWithTimeouts{
+		NotifierResourceModel: mergeNotifierState(flattenNotifier(*notifier), plan.NotifierResourceModel),
+		Timeouts:              plan.Timeouts,
+	})...)
 }
 
 func (r *NotifierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *NotifierResourceModel
+	var data *notifierResourceModelWithTimeouts
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -410,6 +510,12 @@ func (r *NotifierResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, done := operationContext(ctx, data.Timeouts, operationDelete, "notifier", resourceAddress("axiom_notifier", data.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Notifiers.Delete(ctx, data.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete Notifier", err.Error())
 		return
@@ -431,16 +537,16 @@ func extractNotifier(ctx context.Context, plan NotifierResourceModel) (*axiom.No
 	switch {
 	case plan.Properties.Slack != nil:
 		notifier.Properties.Slack = &axiom.SlackConfig{
-			SlackURL: plan.Properties.Slack.SlackURL.ValueString(),
+			SlackURL: secretValue(plan.Properties.Slack.SlackURL, plan.Properties.Slack.SlackURLWO),
 		}
 	case plan.Properties.Discord != nil:
 		notifier.Properties.Discord = &axiom.DiscordConfig{
 			DiscordChannel: plan.Properties.Discord.DiscordChannel.ValueString(),
-			DiscordToken:   plan.Properties.Discord.DiscordToken.ValueString(),
+			DiscordToken:   secretValue(plan.Properties.Discord.DiscordToken, plan.Properties.Discord.DiscordTokenWO),
 		}
 	case plan.Properties.DiscordWebhook != nil:
 		notifier.Properties.DiscordWebhook = &axiom.DiscordWebhookConfig{
-			DiscordWebhookURL: plan.Properties.DiscordWebhook.DiscordWebhookURL.ValueString(),
+			DiscordWebhookURL: secretValue(plan.Properties.DiscordWebhook.DiscordWebhookURL, plan.Properties.DiscordWebhook.DiscordWebhookURLWO),
 		}
 	case plan.Properties.Email != nil:
 		values, diags := typeStringSliceToStringSlice(ctx, plan.Properties.Email.Emails.Elements())
@@ -452,12 +558,12 @@ func extractNotifier(ctx context.Context, plan NotifierResourceModel) (*axiom.No
 		}
 	case plan.Properties.Opsgenie != nil:
 		notifier.Properties.Opsgenie = &axiom.OpsGenieConfig{
-			APIKey: plan.Properties.Opsgenie.APIKey.ValueString(),
+			APIKey: secretValue(plan.Properties.Opsgenie.APIKey, plan.Properties.Opsgenie.APIKeyWO),
 			IsEU:   plan.Properties.Opsgenie.IsEU.ValueBool(),
 		}
 	case plan.Properties.Pagerduty != nil:
 		notifier.Properties.Pagerduty = &axiom.PagerDutyConfig{
-			RoutingKey: plan.Properties.Pagerduty.RoutingKey.ValueString(),
+			RoutingKey: secretValue(plan.Properties.Pagerduty.RoutingKey, plan.Properties.Pagerduty.RoutingKeyWO),
 			Token:      plan.Properties.Pagerduty.Token.ValueString(),
 		}
 	case plan.Properties.Webhook != nil:
@@ -466,7 +572,11 @@ func extractNotifier(ctx context.Context, plan NotifierResourceModel) (*axiom.No
 		}
 	case plan.Properties.CustomWebhook != nil:
 		headers := map[string]string{}
-		diags := plan.Properties.CustomWebhook.Headers.ElementsAs(ctx, &headers, false)
+		headerValues := plan.Properties.CustomWebhook.Headers
+		if !plan.Properties.CustomWebhook.HeadersWO.IsNull() {
+			headerValues = plan.Properties.CustomWebhook.HeadersWO
+		}
+		diags := headerValues.ElementsAs(ctx, &headers, false)
 		if diags.HasError() {
 			return nil, diags
 		}
@@ -480,6 +590,57 @@ func extractNotifier(ctx context.Context, plan NotifierResourceModel) (*axiom.No
 	return &notifier, diags
 }
 
+// withWriteOnlySecrets copies the write-only secrets from the configuration
+// into the plan, where they are always null.
+func withWriteOnlySecrets(plan NotifierResourceModel, config NotifierResourceModel) NotifierResourceModel {
+	if plan.Properties == nil || config.Properties == nil {
+		return plan
+	}
+
+	properties := *plan.Properties
+	if properties.Slack != nil && config.Properties.Slack != nil {
+		slack := *properties.Slack
+		slack.SlackURLWO = config.Properties.Slack.SlackURLWO
+		properties.Slack = &slack
+	}
+	if properties.Discord != nil && config.Properties.Discord != nil {
+		discord := *properties.Discord
+		discord.DiscordTokenWO = config.Properties.Discord.DiscordTokenWO
+		properties.Discord = &discord
+	}
+	if properties.DiscordWebhook != nil && config.Properties.DiscordWebhook != nil {
+		discordWebhook := *properties.DiscordWebhook
+		discordWebhook.DiscordWebhookURLWO = config.Properties.DiscordWebhook.DiscordWebhookURLWO
+		properties.DiscordWebhook = &discordWebhook
+	}
+	if properties.Opsgenie != nil && config.Properties.Opsgenie != nil {
+		opsgenie := *properties.Opsgenie
+		opsgenie.APIKeyWO = config.Properties.Opsgenie.APIKeyWO
+		properties.Opsgenie = &opsgenie
+	}
+	if properties.Pagerduty != nil && config.Properties.Pagerduty != nil {
+		pagerduty := *properties.Pagerduty
+		pagerduty.RoutingKeyWO = config.Properties.Pagerduty.RoutingKeyWO
+		properties.Pagerduty = &pagerduty
+	}
+	if properties.CustomWebhook != nil && config.Properties.CustomWebhook != nil {
+		customWebhook := *properties.CustomWebhook
+		customWebhook.HeadersWO = config.Properties.CustomWebhook.HeadersWO
+		properties.CustomWebhook = &customWebhook
+	}
+
+	plan.Properties = &properties
+	return plan
+}
+
+// secretValue returns the write-only variant of a secret when it is set.
+func secretValue(value types.String, writeOnlyValue types.String) string {
+	if !writeOnlyValue.IsNull() {
+		return writeOnlyValue.ValueString()
+	}
+	return value.ValueString()
+}
+
 func flattenNotifier(notifier axiom.Notifier) NotifierResourceModel {
 	return NotifierResourceModel{
 		ID:         types.StringValue(notifier.ID),
@@ -488,16 +649,43 @@ func flattenNotifier(notifier axiom.Notifier) NotifierResourceModel {
 	}
 }
 
+// mergeNotifierState combines the notifier returned by the API with the plan
+// or prior state. Secrets configured through their write-only variant are null
+// in the source and must stay null in the state, whatever the API returns.
 func mergeNotifierState(remote NotifierResourceModel, source NotifierResourceModel) NotifierResourceModel {
+	remote.SecretVersion = source.SecretVersion
+
 	if remote.Properties == nil || source.Properties == nil {
 		return remote
 	}
 
+	if remote.Properties.Slack != nil && source.Properties.Slack != nil {
+		remote.Properties.Slack.SlackURL = mergeSecret(remote.Properties.Slack.SlackURL, source.Properties.Slack.SlackURL)
+	}
+
+	if remote.Properties.Discord != nil && source.Properties.Discord != nil {
+		remote.Properties.Discord.DiscordToken = mergeSecret(remote.Properties.Discord.DiscordToken, source.Properties.Discord.DiscordToken)
+	}
+
+	if remote.Properties.DiscordWebhook != nil && source.Properties.DiscordWebhook != nil {
+		remote.Properties.DiscordWebhook.DiscordWebhookURL = mergeSecret(remote.Properties.DiscordWebhook.DiscordWebhookURL, source.Properties.DiscordWebhook.DiscordWebhookURL)
+	}
+
+	if remote.Properties.Opsgenie != nil && source.Properties.Opsgenie != nil {
+		remote.Properties.Opsgenie.APIKey = mergeSecret(remote.Properties.Opsgenie.APIKey, source.Properties.Opsgenie.APIKey)
+	}
+
+	if remote.Properties.CustomWebhook != nil && source.Properties.CustomWebhook != nil && source.Properties.CustomWebhook.Headers.IsNull() {
+		remote.Properties.CustomWebhook.Headers = types.MapNull(types.StringType)
+	}
+
 	if remote.Properties.Pagerduty == nil || source.Properties.Pagerduty == nil {
 		return remote
 	}
 
-	if remote.Properties.Pagerduty.RoutingKey.ValueString() == "" {
+	if source.Properties.Pagerduty.RoutingKey.IsNull() {
+		remote.Properties.Pagerduty.RoutingKey = types.StringNull()
+	} else if remote.Properties.Pagerduty.RoutingKey.ValueString() == "" {
 		remote.Properties.Pagerduty.RoutingKey = source.Properties.Pagerduty.RoutingKey
 	}
 
@@ -508,17 +696,28 @@ func mergeNotifierState(remote NotifierResourceModel, source NotifierResourceMod
 	return remote
 }
 
+// mergeSecret keeps a secret out of the state when the source doesn't track
+// it, which is the case when its write-only variant is used.
+func mergeSecret(remote types.String, source types.String) types.String {
+	if source.IsNull() {
+		return types.StringNull()
+	}
+	return remote
+}
+
 func buildNotifierProperties(properties axiom.NotifierProperties) *NotifierProperties {
 	var notifierProperties NotifierProperties
 	if properties.Discord != nil {
 		notifierProperties.Discord = &DiscordConfig{
 			DiscordChannel: types.StringValue(properties.Discord.DiscordChannel),
 			DiscordToken:   types.StringValue(properties.Discord.DiscordToken),
+			DiscordTokenWO: types.StringNull(),
 		}
 	}
 	if properties.DiscordWebhook != nil {
 		notifierProperties.DiscordWebhook = &DiscordWebhookConfig{
-			DiscordWebhookURL: types.StringValue(properties.DiscordWebhook.DiscordWebhookURL),
+			DiscordWebhookURL:   types.StringValue(properties.DiscordWebhook.DiscordWebhookURL),
+			DiscordWebhookURLWO: types.StringNull(),
 		}
 	}
 	if properties.Email != nil {
@@ -528,19 +727,22 @@ func buildNotifierProperties(properties axiom.NotifierProperties) *NotifierPrope
 	}
 	if properties.Opsgenie != nil {
 		notifierProperties.Opsgenie = &OpsGenieConfig{
-			APIKey: types.StringValue(properties.Opsgenie.APIKey),
-			IsEU:   types.BoolValue(properties.Opsgenie.IsEU),
+			APIKey:   types.StringValue(properties.Opsgenie.APIKey),
+			APIKeyWO: types.StringNull(),
+			IsEU:     types.BoolValue(properties.Opsgenie.IsEU),
 		}
 	}
 	if properties.Pagerduty != nil {
 		notifierProperties.Pagerduty = &PagerDutyConfig{
-			RoutingKey: types.StringValue(properties.Pagerduty.RoutingKey),
-			Token:      types.StringValue(properties.Pagerduty.Token),
+			RoutingKey:   types.StringValue(properties.Pagerduty.RoutingKey),
+			RoutingKeyWO: types.StringNull(),
+			Token:        types.StringValue(properties.Pagerduty.Token),
 		}
 	}
 	if properties.Slack != nil {
 		notifierProperties.Slack = &SlackConfig{
-			SlackURL: types.StringValue(properties.Slack.SlackURL),
+			SlackURL:   types.StringValue(properties.Slack.SlackURL),
+			SlackURLWO: types.StringNull(),
 		}
 	}
 	if properties.Webhook != nil {
@@ -556,9 +758,10 @@ func buildNotifierProperties(properties axiom.NotifierProperties) *NotifierPrope
 		headers := types.MapValueMust(types.StringType, headerValues)
 
 		notifierProperties.CustomWebhook = &CustomWebhookConfig{
-			URL:     types.StringValue(properties.CustomWebhook.URL),
-			Headers: headers,
-			Body:    types.StringValue(properties.CustomWebhook.Body),
+			URL:       types.StringValue(properties.CustomWebhook.URL),
+			Headers:   headers,
+			HeadersWO: types.MapNull(types.StringType),
+			Body:      types.StringValue(properties.CustomWebhook.Body),
 		}
 	}
 	return &notifierProperties
@@ -1,9 +1,13 @@
 package axiom
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/axiomhq/axiom-go/axiom"
 )
 
 func TestMergeNotifierStatePagerDutyFallback(t *testing.T) {
@@ -65,3 +69,121 @@ func TestMergeNotifierStatePagerDutyKeepsRemoteValues(t *testing.T) {
 		t.Fatalf("Token = %q, expected api-token", got)
 	}
 }
+
+func TestMergeNotifierStateWriteOnlySecrets(t *testing.T) {
+	source := NotifierResourceModel{
+		SecretVersion: types.Int64Value(2),
+		Properties: &NotifierProperties{
+			Slack: &SlackConfig{
+				SlackURL: types.StringNull(),
+			},
+		},
+	}
+
+	remote := flattenNotifier(axiom.Notifier{
+		Properties: axiom.NotifierProperties{
+			Slack: &axiom.SlackConfig{SlackURL: "https://hooks.slack.com/services/secret"},
+		},
+	})
+
+	merged := mergeNotifierState(remote, source)
+
+	if !merged.Properties.Slack.SlackURL.IsNull() {
+		t.Fatalf("SlackURL = %q, expected null", merged.Properties.Slack.SlackURL.ValueString())
+	}
+
+	if !merged.Properties.Slack.SlackURLWO.IsNull() {
+		t.Fatalf("SlackURLWO = %q, expected null", merged.Properties.Slack.SlackURLWO.ValueString())
+	}
+
+	if got := merged.SecretVersion.ValueInt64(); got != 2 {
+		t.Fatalf("SecretVersion = %d, expected 2", got)
+	}
+}
+
+func TestMergeNotifierStatePagerDutyWriteOnlyRoutingKey(t *testing.T) {
+	source := NotifierResourceModel{
+		Properties: &NotifierProperties{
+			Pagerduty: &PagerDutyConfig{
+				RoutingKey: types.StringNull(),
+				Token:      types.StringNull(),
+			},
+		},
+	}
+
+	remote := NotifierResourceModel{
+		Properties: &NotifierProperties{
+			Pagerduty: &PagerDutyConfig{
+				RoutingKey: types.StringValue("api-routing-key"),
+				Token:      types.StringValue(""),
+			},
+		},
+	}
+
+	merged := mergeNotifierState(remote, source)
+
+	if !merged.Properties.Pagerduty.RoutingKey.IsNull() {
+		t.Fatalf("RoutingKey = %q, expected null", merged.Properties.Pagerduty.RoutingKey.ValueString())
+	}
+}
+
+func TestExtractNotifierWriteOnlySecrets(t *testing.T) {
+	ctx := context.Background()
+
+	plan := NotifierResourceModel{
+		Name: types.StringValue("webhook"),
+		Properties: &NotifierProperties{
+			CustomWebhook: &CustomWebhookConfig{
+				URL:       types.StringValue("https://example.com"),
+				Headers:   types.MapNull(types.StringType),
+				HeadersWO: types.MapNull(types.StringType),
+				Body:      types.StringValue("{}"),
+			},
+		},
+	}
+
+	config := plan
+	config.Properties = &NotifierProperties{
+		CustomWebhook: &CustomWebhookConfig{
+			URL:     plan.Properties.CustomWebhook.URL,
+			Headers: types.MapNull(types.StringType),
+			HeadersWO: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"Authorization": types.StringValue("Bearer secret"),
+			}),
+			Body: plan.Properties.CustomWebhook.Body,
+		},
+	}
+
+	notifier, diags := extractNotifier(ctx, withWriteOnlySecrets(plan, config))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := notifier.Properties.CustomWebhook.Headers["Authorization"]; got != "Bearer secret" {
+		t.Fatalf("Authorization header = %q, expected Bearer secret", got)
+	}
+
+	if !plan.Properties.CustomWebhook.HeadersWO.IsNull() {
+		t.Fatal("plan was modified, expected write-only headers to stay null")
+	}
+
+	slackPlan := NotifierResourceModel{
+		Properties: &NotifierProperties{
+			Slack: &SlackConfig{SlackURL: types.StringNull(), SlackURLWO: types.StringNull()},
+		},
+	}
+	slackConfig := NotifierResourceModel{
+		Properties: &NotifierProperties{
+			Slack: &SlackConfig{SlackURL: types.StringNull(), SlackURLWO: types.StringValue("https://hooks.slack.com/services/secret")},
+		},
+	}
+
+	notifier, diags = extractNotifier(ctx, withWriteOnlySecrets(slackPlan, slackConfig))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := notifier.Properties.Slack.SlackURL; got != "https://hooks.slack.com/services/secret" {
+		t.Fatalf("SlackURL = %q, expected the write-only value", got)
+	}
+}
@@ -0,0 +1,95 @@
+package axiom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceOperation is a resource operation that can time out. Its value is
+// the name of the attribute of the timeouts block that configures it.
+type resourceOperation string
+
+const (
+	operationCreate resourceOperation = "create"
+	operationRead   resourceOperation = "read"
+	operationUpdate resourceOperation = "update"
+	operationDelete resourceOperation = "delete"
+)
+
+// Default timeouts of the resource operations, used unless overridden in the
+// timeouts block of a resource.
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+// timeoutsBlock returns the timeouts block every resource exposes.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// nullTimeouts returns an unset timeouts block, for state that is not derived
+// from a configuration such as upgraded state.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			string(operationCreate): types.StringType,
+			string(operationRead):   types.StringType,
+			string(operationUpdate): types.StringType,
+			string(operationDelete): types.StringType,
+		}),
+	}
+}
+
+// operationContext bounds ctx by the timeout configured for the operation of
+// the resource, or its default, and tags it with the address of the resource
+// for logging. The returned function must be deferred: it releases the context
+// and reports when the operation ran out of time.
+func operationContext(ctx context.Context, value timeouts.Value, operation resourceOperation, resourceName string, address string, diags *diag.Diagnostics) (context.Context, func()) {
+	var timeout time.Duration
+	var timeoutDiags diag.Diagnostics
+	switch operation {
+	case operationCreate:
+		timeout, timeoutDiags = value.Create(ctx, defaultCreateTimeout)
+	case operationRead:
+		timeout, timeoutDiags = value.Read(ctx, defaultReadTimeout)
+	case operationUpdate:
+		timeout, timeoutDiags = value.Update(ctx, defaultUpdateTimeout)
+	case operationDelete:
+		timeout, timeoutDiags = value.Delete(ctx, defaultDeleteTimeout)
+	default:
+		diags.AddError(
+			"Unknown Resource Operation",
+			fmt.Sprintf("No timeout is defined for the %s of the %s. This is always a problem with the provider, please report it.", operation, resourceName),
+		)
+		return ctx, func() {}
+	}
+	diags.Append(timeoutDiags...)
+
+	ctx, cancel := context.WithTimeout(withResourceAddress(ctx, address), timeout)
+
+	return ctx, func() {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			diags.AddError(
+				"Operation Timed Out",
+				fmt.Sprintf("The %s of the %s did not complete within %s. Increase timeouts.%s if the Axiom API needs more time.", operation, resourceName, timeout, operation),
+			)
+		}
+		cancel()
+	}
+}
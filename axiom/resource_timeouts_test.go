@@ -0,0 +1,118 @@
+package axiom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcesExposeTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for _, newResource := range (&axiomProvider{}).Resources(ctx) {
+		r := newResource()
+
+		var metadataResp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "axiom"}, &metadataResp)
+
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError())
+
+			assert.Contains(t, schemaResp.Schema.Blocks, "timeouts")
+		})
+	}
+}
+
+func TestOperationContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uses the default timeout", func(t *testing.T) {
+		t.Parallel()
+
+		var diags diag.Diagnostics
+		ctx, done := operationContext(context.Background(), nullTimeouts(), operationDelete, "dataset", `axiom_dataset (id "logs")`, &diags)
+		defer done()
+
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(defaultDeleteTimeout), deadline, time.Minute)
+		assert.Equal(t, `axiom_dataset (id "logs")`, resourceAddressFromContext(ctx))
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("reports the operation that timed out", func(t *testing.T) {
+		t.Parallel()
+
+		var diags diag.Diagnostics
+		ctx, done := operationContext(context.Background(), configuredTimeouts(operationCreate, "1ms"), Create, "monitor", "", &diags)
+		<-ctx.Done()
+		done()
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Operation Timed Out", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "The create of the monitor did not complete within 1ms")
+		assert.Contains(t, diags[0].Detail(), "timeouts.create")
+	})
+
+	t.Run("doesn't report operations that finished in time", func(t *testing.T) {
+		t.Parallel()
+
+		var diags diag.Diagnostics
+		_, done := operationContext(context.Background(), configuredTimeouts(operationUpdate, "1h"), Update, "token", "", &diags)
+		done()
+
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("reports unknown operations", func(t *testing.T) {
+		t.Parallel()
+
+		var diags diag.Diagnostics
+		ctx, done := operationContext(context.Background(), nullTimeouts(), resourceOperation("import"), "user", "", &diags)
+		defer done()
+
+		require.True(t, diags.HasError())
+		assert.Equal(t, "Unknown Resource Operation", diags[0].Summary())
+		assert.NoError(t, ctx.Err())
+	})
+
+	t.Run("rejects invalid timeouts", func(t *testing.T) {
+		t.Parallel()
+
+		var diags diag.Diagnostics
+		_, done := operationContext(context.Background(), configuredTimeouts(operationRead, "soon"), Read, "user", "", &diags)
+		defer done()
+
+		assert.True(t, diags.HasError())
+	})
+}
+
+// configuredTimeouts returns a timeouts block with only the given operation
+// set to the given duration.
+func configuredTimeouts(operation resourceOperation, duration string) timeouts.Value {
+	attributes := map[string]attr.Value{
+		string(operationCreate): types.StringNull(),
+		string(operationRead):   types.StringNull(),
+		string(operationUpdate): types.StringNull(),
+		string(operationDelete): types.StringNull(),
+	}
+	attributes[string(operation)] = types.StringValue(duration)
+
+	return timeouts.Value{
+		Object: types.ObjectValueMust(nullTimeouts().AttributeTypes(context.Background()), attributes),
+	}
+}
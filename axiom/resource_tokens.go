@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -25,9 +26,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &TokenResource{}
-	_ resource.ResourceWithImportState = &TokenResource{}
-	_ resource.ResourceWithModifyPlan  = &TokenResource{}
+	_ resource.Resource                 = &TokenResource{}
+	_ resource.ResourceWithImportState  = &TokenResource{}
+	_ resource.ResourceWithModifyPlan   = &TokenResource{}
+	_ resource.ResourceWithUpgradeState = &TokenResource{}
 )
 
 const (
@@ -61,6 +63,13 @@ type TokensResourceModel struct {
 	Token               types.String `tfsdk:"token"`
 }
 
+// tokensResourceModelWithTimeouts adds the timeouts block, which only the
+// managed resource exposes, to the token model shared with the data source.
+type tokensResourceModelWithTimeouts struct {
+	TokensResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type DatasetCapabilities struct {
 	Ingest         types.List `tfsdk:"ingest"`
 	Query          types.List `tfsdk:"query"`
@@ -123,7 +132,7 @@ func (r *TokenResource) Metadata(_ context.Context, req resource.MetadataRequest
 	resp.TypeName = req.ProviderTypeName + "_token"
 }
 
-func (r *TokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TokenResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
@@ -433,27 +442,19 @@ func (r *TokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
 func (r *TokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*axiom.Client)
-
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *TokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -461,8 +462,8 @@ func (r *TokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
-	var state TokensResourceModel
-	var plan TokensResourceModel
+	var state tokensResourceModelWithTimeouts
+	var plan tokensResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -470,7 +471,7 @@ func (r *TokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
-	if !tokenWillRegenerate(plan, state) {
+	if !tokenWillRegenerate(plan.TokensResourceModel, state.TokensResourceModel) {
 		return
 	}
 
@@ -495,7 +496,7 @@ func tokenWillRegenerate(plan TokensResourceModel, state TokensResourceModel) bo
 }
 
 func (r *TokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan TokensResourceModel
+	var plan tokensResourceModelWithTimeouts
 
 	// Read Terraform plan into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -504,12 +505,18 @@ func (r *TokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "token", resourceAddress("axiom_token", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	tokenReq, diags := buildCreateTokenRequest(ctx, plan)
+	tokenReq, diags := buildCreateTokenRequest(ctx, plan.TokensResourceModel)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -527,11 +534,14 @@ func (r *TokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, createTokenResponse)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, tokensResourceModelWithTimeouts{
+		TokensResourceModel: createTokenResponse,
+		Timeouts:            plan.Timeouts,
+	})...)
 }
 
 func (r *TokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan TokensResourceModel
+	var plan tokensResourceModelWithTimeouts
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
@@ -540,6 +550,12 @@ func (r *TokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "token", resourceAddress("axiom_token", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiToken, err := r.client.Tokens.Get(ctx, plan.ID.ValueString())
 	if err != nil {
 		if isNotFoundError(err) {
@@ -563,13 +579,16 @@ func (r *TokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Preserve the token value from state since API doesn't return it
 	token.Token = plan.Token
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, token)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, tokensResourceModelWithTimeouts{
+		TokensResourceModel: token,
+		Timeouts:            plan.Timeouts,
+	})...)
 }
 
 func (r *TokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan TokensResourceModel
-	var state TokensResourceModel
-	var config TokensResourceModel
+	var plan tokensResourceModelWithTimeouts
+	var state tokensResourceModelWithTimeouts
+	var config tokensResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -578,18 +597,31 @@ func (r *TokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "token", resourceAddress("axiom_token", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
 	}
 
-	newTokenReq, diags := buildCreateTokenRequest(ctx, plan)
+	// Only the timeouts changed, so there is nothing to regenerate.
+	if !tokenWillRegenerate(plan.TokensResourceModel, state.TokensResourceModel) {
+		state.Timeouts = plan.Timeouts
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	newTokenReq, diags := buildCreateTokenRequest(ctx, plan.TokensResourceModel)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	gracePeriod, diags := extractRotationGracePeriod(config)
+	gracePeriod, diags := extractRotationGracePeriod(config.TokensResourceModel)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -610,11 +642,14 @@ func (r *TokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, updatedState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, tokensResourceModelWithTimeouts{
+		TokensResourceModel: updatedState,
+		Timeouts:            plan.Timeouts,
+	})...)
 }
 
 func (r *TokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var plan TokensResourceModel
+	var plan tokensResourceModelWithTimeouts
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
@@ -623,6 +658,12 @@ func (r *TokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "token", resourceAddress("axiom_token", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Tokens.Delete(ctx, plan.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete token", err.Error())
 		return
@@ -633,6 +674,145 @@ func (r *TokenResource) ImportState(ctx context.Context, req resource.ImportStat
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// tokensResourceModelV0 describes the version 0 state of a token, written
+// before the data, trim and vacuum dataset capabilities existed.
+type tokensResourceModelV0 struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+	DatasetCapabilities types.Map    `tfsdk:"dataset_capabilities"`
+	OrgCapabilities     types.Object `tfsdk:"org_capabilities"`
+	Token               types.String `tfsdk:"token"`
+}
+
+type datasetCapabilitiesV0 struct {
+	Ingest         types.List `tfsdk:"ingest"`
+	Query          types.List `tfsdk:"query"`
+	StarredQueries types.List `tfsdk:"starred_queries"`
+	VirtualFields  types.List `tfsdk:"virtual_fields"`
+}
+
+func (r *TokenResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	capability := schema.ListAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+
+	orgCapabilities := map[string]schema.Attribute{}
+	for name := range (OrgCapabilities{}).Types() {
+		orgCapabilities[name] = capability
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Required: true},
+					"description": schema.StringAttribute{Optional: true},
+					"expires_at":  schema.StringAttribute{Optional: true},
+					"token": schema.StringAttribute{
+						Computed:  true,
+						Sensitive: true,
+					},
+					"dataset_capabilities": schema.MapNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"ingest":          capability,
+								"query":           capability,
+								"starred_queries": capability,
+								"virtual_fields":  capability,
+							},
+						},
+					},
+					"org_capabilities": schema.SingleNestedAttribute{
+						Optional:   true,
+						Attributes: orgCapabilities,
+					},
+				},
+			},
+			StateUpgrader: upgradeTokenStateV0,
+		},
+	}
+}
+
+// upgradeTokenStateV0 upgrades the state to version 1. Capabilities that were
+// not set are stored as empty lists, matching the defaults of the schema.
+func upgradeTokenStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior tokensResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorDatasetCapabilities := map[string]datasetCapabilitiesV0{}
+	if !prior.DatasetCapabilities.IsNull() {
+		resp.Diagnostics.Append(prior.DatasetCapabilities.ElementsAs(ctx, &priorDatasetCapabilities, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	datasetCapabilities := make(map[string]DatasetCapabilities, len(priorDatasetCapabilities))
+	for dataset, capabilities := range priorDatasetCapabilities {
+		datasetCapabilities[dataset] = DatasetCapabilities{
+			Ingest:         emptyListIfNull(capabilities.Ingest),
+			Query:          emptyListIfNull(capabilities.Query),
+			StarredQueries: emptyListIfNull(capabilities.StarredQueries),
+			VirtualFields:  emptyListIfNull(capabilities.VirtualFields),
+			Data:           types.ListValueMust(types.StringType, []attr.Value{}),
+			Trim:           types.ListValueMust(types.StringType, []attr.Value{}),
+			Vacuum:         types.ListValueMust(types.StringType, []attr.Value{}),
+		}
+	}
+
+	datasetCapabilitiesValue, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: DatasetCapabilities{}.Types()}, datasetCapabilities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgCapabilities := types.ObjectNull(OrgCapabilities{}.Types())
+	if !prior.OrgCapabilities.IsNull() {
+		attributes := prior.OrgCapabilities.Attributes()
+		for name, value := range attributes {
+			if list, ok := value.(types.List); ok {
+				attributes[name] = emptyListIfNull(list)
+			}
+		}
+
+		orgCapabilities, diags = types.ObjectValue(OrgCapabilities{}.Types(), attributes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, tokensResourceModelWithTimeouts{
+		TokensResourceModel: TokensResourceModel{
+			ID:                  prior.ID,
+			Name:                prior.Name,
+			Description:         prior.Description,
+			ExpiresAt:           prior.ExpiresAt,
+			RotationGracePeriod: types.StringNull(),
+			DatasetCapabilities: datasetCapabilitiesValue,
+			OrgCapabilities:     orgCapabilities,
+			Token:               prior.Token,
+		},
+		Timeouts: nullTimeouts(),
+	})...)
+}
+
+func emptyListIfNull(list types.List) types.List {
+	if list.IsNull() {
+		return types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	return list
+}
+
 func buildCreateTokenRequest(ctx context.Context, plan TokensResourceModel) (axiom.CreateTokenRequest, diag.Diagnostics) {
 	datasetCapabilities, diags := extractDatasetCapabilities(ctx, plan)
 	if diags.HasError() {
@@ -1,6 +1,7 @@
 package axiom
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -75,3 +76,59 @@ func TestValidateDatasetCapabilities(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestTokenResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	state := upgradeStateFromJSON(t, &TokenResource{}, 0, `{
+		"id": "tok-1",
+		"name": "ingest",
+		"description": null,
+		"expires_at": "2030-01-01T00:00:00Z",
+		"token": "xaat-secret",
+		"dataset_capabilities": {
+			"logs": {
+				"ingest": ["create"],
+				"query": null,
+				"starred_queries": [],
+				"virtual_fields": []
+			}
+		},
+		"org_capabilities": {
+			"annotations": null,
+			"api_tokens": ["read"],
+			"audit_log": null,
+			"billing": null,
+			"dashboards": null,
+			"datasets": null,
+			"endpoints": null,
+			"flows": null,
+			"integrations": null,
+			"monitors": null,
+			"notifiers": null,
+			"rbac": null,
+			"shared_access_keys": null,
+			"users": null
+		}
+	}`)
+
+	ctx := context.Background()
+
+	var upgraded tokensResourceModelWithTimeouts
+	require.False(t, state.Get(ctx, &upgraded).HasError())
+
+	assert.Equal(t, "tok-1", upgraded.ID.ValueString())
+	assert.Equal(t, "xaat-secret", upgraded.Token.ValueString())
+	assert.Equal(t, "2030-01-01T00:00:00Z", upgraded.ExpiresAt.ValueString())
+
+	tokenReq, diags := buildCreateTokenRequest(ctx, upgraded.TokensResourceModel)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []axiom.Action{axiom.ActionCreate}, tokenReq.DatasetCapabilities["logs"].Ingest)
+	assert.Empty(t, tokenReq.DatasetCapabilities["logs"].Trim)
+	assert.Equal(t, []axiom.Action{axiom.ActionRead}, tokenReq.OrganisationCapabilities.APITokens)
+
+	datasetCapabilities := map[string]DatasetCapabilities{}
+	require.False(t, upgraded.DatasetCapabilities.ElementsAs(ctx, &datasetCapabilities, false).HasError())
+	assert.False(t, datasetCapabilities["logs"].Query.IsNull())
+	assert.False(t, datasetCapabilities["logs"].Vacuum.IsNull())
+}
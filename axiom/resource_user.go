@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,8 +17,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &UserResource{}
-	_ resource.ResourceWithImportState = &UserResource{}
+	_ resource.Resource                 = &UserResource{}
+	_ resource.ResourceWithImportState  = &UserResource{}
+	_ resource.ResourceWithUpgradeState = &UserResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -37,11 +39,18 @@ type UsersResourceModel struct {
 	Role  types.String `tfsdk:"role"`
 }
 
+// usersResourceModelWithTimeouts adds the timeouts block, which only the
+// managed resource exposes, to the user model shared with the data source.
+type usersResourceModelWithTimeouts struct {
+	UsersResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
 
-func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *UserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
@@ -68,31 +77,23 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
 func (r *UserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*axiom.Client)
-
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan UsersResourceModel
+	var plan usersResourceModelWithTimeouts
 
 	// Read Terraform plan plan into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -101,6 +102,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "user", resourceAddress("axiom_user", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
@@ -116,11 +123,14 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenUser(user))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, usersResourceModelWithTimeouts{
+		UsersResourceModel: flattenUser(user),
+		Timeouts:           plan.Timeouts,
+	})...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan UsersResourceModel
+	var plan usersResourceModelWithTimeouts
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
@@ -129,17 +139,26 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "user", resourceAddress("axiom_user", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	user, err := r.client.Users.Get(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read user", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenUser(user))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, usersResourceModelWithTimeouts{
+		UsersResourceModel: flattenUser(user),
+		Timeouts:           plan.Timeouts,
+	})...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan UsersResourceModel
+	var plan usersResourceModelWithTimeouts
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -148,6 +167,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "user", resourceAddress("axiom_user", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	user, err := r.client.Users.Update(ctx, plan.ID.ValueString(), axiom.UpdateUserRequest{
 		Name: plan.Name.ValueString(),
 	})
@@ -156,11 +181,14 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenUser(user))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, usersResourceModelWithTimeouts{
+		UsersResourceModel: flattenUser(user),
+		Timeouts:           plan.Timeouts,
+	})...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var plan UsersResourceModel
+	var plan usersResourceModelWithTimeouts
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
@@ -169,6 +197,12 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "user", resourceAddress("axiom_user", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.Users.Delete(ctx, plan.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete user", err.Error())
 		return
@@ -179,6 +213,46 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *UserResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"email": schema.StringAttribute{
+						Required: true,
+					},
+					"role": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			StateUpgrader: upgradeUserStateV0,
+		},
+	}
+}
+
+// upgradeUserStateV0 upgrades the state to version 1. The attributes are
+// unchanged, only the schema version was bumped.
+func upgradeUserStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior UsersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, usersResourceModelWithTimeouts{
+		UsersResourceModel: prior,
+		Timeouts:           nullTimeouts(),
+	})...)
+}
+
 func flattenUser(user *axiom.User) UsersResourceModel {
 	return UsersResourceModel{
 		ID:    types.StringValue(user.ID),
@@ -0,0 +1,28 @@
+package axiom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	state := upgradeStateFromJSON(t, &UserResource{}, 0, `{
+		"id": "u-123",
+		"name": "Jane Doe",
+		"email": "jane@example.com",
+		"role": "admin"
+	}`)
+
+	var upgraded usersResourceModelWithTimeouts
+	require.False(t, state.Get(context.Background(), &upgraded).HasError())
+
+	assert.Equal(t, "u-123", upgraded.ID.ValueString())
+	assert.Equal(t, "Jane Doe", upgraded.Name.ValueString())
+	assert.Equal(t, "jane@example.com", upgraded.Email.ValueString())
+	assert.Equal(t, "admin", upgraded.Role.ValueString())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -42,11 +43,19 @@ type VirtualFieldResourceModel struct {
 	Unit        types.String `tfsdk:"unit"`
 }
 
+// virtualFieldResourceModelWithTimeouts adds the timeouts block, which only
+// the managed resource exposes, to the virtual field model shared with the
+// data source.
+type virtualFieldResourceModelWithTimeouts struct {
+	VirtualFieldResourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *VirtualFieldResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_virtual_field"
 }
 
-func (r *VirtualFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VirtualFieldResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Version: 1,
 		Attributes: map[string]schema.Attribute{
@@ -86,35 +95,35 @@ func (r *VirtualFieldResource) Schema(_ context.Context, _ resource.SchemaReques
 				Default:             stringdefault.StaticString(""),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
 func (r *VirtualFieldResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*axiom.Client)
+	data, ok := providerDataFromConfigure(req.ProviderData, "Resource", &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *axiom.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
 		return
 	}
 
-	r.client = client
+	r.client = data.client
 }
 
 func (r *VirtualFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan VirtualFieldResourceModel
+	var plan virtualFieldResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationCreate, "virtual field", resourceAddress("axiom_virtual_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if r.client == nil {
 		resp.Diagnostics.AddError("Client Error", "Client is not set")
 		return
@@ -133,17 +142,26 @@ func (r *VirtualFieldResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenVirtualField(vfield))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, virtualFieldResourceModelWithTimeouts{
+		VirtualFieldResourceModel: flattenVirtualField(vfield),
+		Timeouts:                  plan.Timeouts,
+	})...)
 }
 
 func (r *VirtualFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var plan VirtualFieldResourceModel
+	var plan virtualFieldResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationRead, "virtual field", resourceAddress("axiom_virtual_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vfield, err := r.client.VirtualFields.Get(ctx, plan.ID.ValueString())
 	if err != nil {
 		if isNotFoundError(err) {
@@ -158,17 +176,26 @@ func (r *VirtualFieldResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenVirtualField(vfield))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, virtualFieldResourceModelWithTimeouts{
+		VirtualFieldResourceModel: flattenVirtualField(vfield),
+		Timeouts:                  plan.Timeouts,
+	})...)
 }
 
 func (r *VirtualFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan VirtualFieldResourceModel
+	var plan virtualFieldResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationUpdate, "virtual field", resourceAddress("axiom_virtual_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vfield, err := r.client.VirtualFields.Update(ctx, plan.ID.ValueString(), axiom.VirtualField{
 		Dataset:     plan.Dataset.ValueString(),
 		Name:        plan.Name.ValueString(),
@@ -182,17 +209,26 @@ func (r *VirtualFieldResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, flattenVirtualField(vfield))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, virtualFieldResourceModelWithTimeouts{
+		VirtualFieldResourceModel: flattenVirtualField(vfield),
+		Timeouts:                  plan.Timeouts,
+	})...)
 }
 
 func (r *VirtualFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var plan VirtualFieldResourceModel
+	var plan virtualFieldResourceModelWithTimeouts
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, done := operationContext(ctx, plan.Timeouts, operationDelete, "virtual field", resourceAddress("axiom_virtual_field", plan.ID), &resp.Diagnostics)
+	defer done()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.client.VirtualFields.Delete(ctx, plan.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Failed to delete Virtual Field", err.Error())
 		return
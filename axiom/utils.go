@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func typeStringSliceToStringSlice(ctx context.Context, s []attr.Value) ([]string, diag.Diagnostics) {
@@ -89,6 +91,7 @@ func convertAttribute(resourceAttribute resourceschema.Attribute) datasourcesche
 	case resourceschema.StringAttribute:
 		return datasourceschema.StringAttribute{
 			Computed:            true,
+			CustomType:          attr.CustomType,
 			Description:         attr.Description,
 			MarkdownDescription: attr.MarkdownDescription,
 		}
@@ -159,6 +162,117 @@ func convertAttribute(resourceAttribute resourceschema.Attribute) datasourcesche
 	}
 }
 
+// convertEphemeralAttributes converts configurable resource attributes into
+// their ephemeral resource equivalent. Ephemeral resources don't support
+// defaults or plan modifiers, so only the configurability, sensitivity,
+// validators and descriptions are carried over.
+func convertEphemeralAttributes(attributes map[string]resourceschema.Attribute) map[string]ephemeralschema.Attribute {
+	result := make(map[string]ephemeralschema.Attribute, len(attributes))
+	for k, v := range attributes {
+		result[k] = convertEphemeralAttribute(v)
+	}
+	return result
+}
+
+func convertEphemeralAttribute(resourceAttribute resourceschema.Attribute) ephemeralschema.Attribute {
+	switch attr := resourceAttribute.(type) {
+	case resourceschema.StringAttribute:
+		return ephemeralschema.StringAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Computed:            !attr.Required && !attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			Validators:          attr.Validators,
+		}
+	case resourceschema.ListAttribute:
+		return ephemeralschema.ListAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Computed:            !attr.Required && !attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			ElementType:         attr.ElementType,
+			Validators:          attr.Validators,
+		}
+	case resourceschema.MapNestedAttribute:
+		return ephemeralschema.MapNestedAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Computed:            !attr.Required && !attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			NestedObject: ephemeralschema.NestedAttributeObject{
+				Attributes: convertEphemeralAttributes(attr.NestedObject.Attributes),
+				Validators: attr.NestedObject.Validators,
+			},
+			Validators: attr.Validators,
+		}
+	case resourceschema.SingleNestedAttribute:
+		return ephemeralschema.SingleNestedAttribute{
+			Required:            attr.Required,
+			Optional:            attr.Optional,
+			Computed:            !attr.Required && !attr.Optional,
+			Sensitive:           attr.Sensitive,
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			Attributes:          convertEphemeralAttributes(attr.Attributes),
+			Validators:          attr.Validators,
+		}
+	default:
+		panic(fmt.Sprintf("unsupported ephemeral attribute type: %T", resourceAttribute))
+	}
+}
+
+// boolOrDefault returns the value, or the default when it is null. Used when
+// upgrading state written before an attribute had a default.
+func boolOrDefault(value types.Bool, def bool) types.Bool {
+	if value.IsNull() {
+		return types.BoolValue(def)
+	}
+	return value
+}
+
+func int64OrDefault(value types.Int64, def int64) types.Int64 {
+	if value.IsNull() {
+		return types.Int64Value(def)
+	}
+	return value
+}
+
+func float64OrDefault(value types.Float64, def float64) types.Float64 {
+	if value.IsNull() {
+		return types.Float64Value(def)
+	}
+	return value
+}
+
+func stringOrDefault(value types.String, def string) types.String {
+	if value.IsNull() {
+		return types.StringValue(def)
+	}
+	return value
+}
+
+// httpErrorStatus returns the status code of an API error, or 0 if err is not
+// an API error.
+func httpErrorStatus(err error) int {
+	var apiError axiom.HTTPError
+	if errors.As(err, &apiError) {
+		return apiError.Status
+	}
+
+	var apiErrorPtr *axiom.HTTPError
+	if errors.As(err, &apiErrorPtr) && apiErrorPtr != nil {
+		return apiErrorPtr.Status
+	}
+
+	return 0
+}
+
 func isNotFoundError(err error) bool {
 	if errors.Is(err, axiom.ErrNotFound) {
 		return true
@@ -1,13 +1,76 @@
 package axiom
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
 	ax "github.com/axiomhq/axiom-go/axiom"
 )
 
+// upgradeStateFromJSON runs recorded state JSON of the given schema version
+// through the state upgrader of the resource, the same way Terraform does.
+func upgradeStateFromJSON(t *testing.T, r resource.ResourceWithUpgradeState, version int64, rawState string) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	require.True(t, ok, "no state upgrader for version %d", version)
+	require.NotNil(t, upgrader.PriorSchema)
+
+	prior, err := (&tfprotov6.RawState{JSON: []byte(rawState)}).UnmarshalWithOpts(
+		upgrader.PriorSchema.Type().TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	require.NoError(t, err)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Raw: prior, Schema: *upgrader.PriorSchema},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			Schema: schemaResp.Schema,
+		},
+	}
+
+	upgrader.StateUpgrader(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	return resp.State
+}
+
+// newTestState returns state of the resource holding the given model.
+func newTestState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+	require.False(t, state.Set(ctx, model).HasError())
+
+	return state
+}
+
 func TestIsNotFoundError(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,267 @@
+// Package apl implements a lexer and a structural parser of the Axiom
+// Processing Language (APL), so that queries can be checked for syntax errors
+// without calling the API.
+//
+// The parser checks the structure of a query: statements, the tabular source,
+// the pipes between tabular operators and the names of the operators. Names
+// the parser doesn't know are reported as warnings rather than errors, so that
+// queries using newer APL features still pass. The arguments of the operators
+// are only checked for balanced brackets and terminated strings, as validating
+// expressions requires the schema of the queried datasets.
+package apl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is the kind of a token.
+type TokenKind int
+
+// Kinds of tokens.
+const (
+	// Ident is an identifier or keyword, such as where or _time.
+	Ident TokenKind = iota
+	// String is a string literal, including its quotes.
+	String
+	// Number is a number literal, including timespans such as 5m.
+	Number
+	// Punct is an operator or punctuation, such as |, ( or ==.
+	Punct
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case Ident:
+		return "identifier"
+	case String:
+		return "string"
+	case Number:
+		return "number"
+	case Punct:
+		return "punctuation"
+	default:
+		return fmt.Sprintf("TokenKind(%d)", int(k))
+	}
+}
+
+// Pos is a position in the source of a query. Line and Column start at 1 and
+// Column counts characters, not bytes.
+type Pos struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// Token is a lexical token of a query.
+type Token struct {
+	Kind TokenKind
+	Text string
+	Pos  Pos
+	// End is the offset just past the token.
+	End int
+}
+
+// Is reports whether the token is the given punctuation or identifier.
+func (t Token) Is(text string) bool {
+	return (t.Kind == Punct || t.Kind == Ident) && t.Text == text
+}
+
+// Error is a syntax error at a position of a query.
+type Error struct {
+	Pos Pos
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Pos.Line, e.Pos.Column, e.Msg)
+}
+
+// Excerpt returns the line of src the error is on, followed by a line with a
+// caret pointing at the column of the error.
+func (e *Error) Excerpt(src string) string {
+	lines := strings.Split(src, "\n")
+	if e.Pos.Line < 1 || e.Pos.Line > len(lines) {
+		return ""
+	}
+
+	line := strings.ReplaceAll(strings.TrimRight(lines[e.Pos.Line-1], "\r"), "\t", " ")
+	return line + "\n" + strings.Repeat(" ", max(e.Pos.Column-1, 0)) + "^"
+}
+
+// punctuation lists the operators and punctuation of APL, longest first.
+var punctuation = []string{
+	"==", "!=", "<=", ">=", "=~", "!~", "=>", "..",
+	"|", "(", ")", "[", "]", "{", "}", ",", ";", ".", ":",
+	"=", "<", ">", "+", "-", "*", "/", "%", "!", "?", "~",
+}
+
+// Lex splits the source of a query into tokens. Comments and whitespace are
+// skipped.
+func Lex(src string) ([]Token, error) {
+	l := &lexer{src: src, line: 1, column: 1}
+
+	var tokens []Token
+	for {
+		l.skipSpaceAndComments()
+		if l.offset >= len(l.src) {
+			return tokens, nil
+		}
+
+		token, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+}
+
+type lexer struct {
+	src    string
+	offset int
+	line   int
+	column int
+}
+
+func (l *lexer) pos() Pos {
+	return Pos{Offset: l.offset, Line: l.line, Column: l.column}
+}
+
+func (l *lexer) peek(n int) byte {
+	if l.offset+n >= len(l.src) {
+		return 0
+	}
+	return l.src[l.offset+n]
+}
+
+// advance moves past n bytes, keeping track of lines and columns.
+func (l *lexer) advance(n int) {
+	end := min(l.offset+n, len(l.src))
+	for l.offset < end {
+		r, size := utf8.DecodeRuneInString(l.src[l.offset:])
+		l.offset += size
+		if r == '\n' {
+			l.line++
+			l.column = 1
+		} else {
+			l.column++
+		}
+	}
+}
+
+func (l *lexer) skipSpaceAndComments() {
+	for l.offset < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.src[l.offset:])
+		switch {
+		case unicode.IsSpace(r):
+			l.advance(size)
+		case strings.HasPrefix(l.src[l.offset:], "//"):
+			end := strings.IndexByte(l.src[l.offset:], '\n')
+			if end < 0 {
+				end = len(l.src) - l.offset
+			}
+			l.advance(end)
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) next() (Token, error) {
+	start := l.pos()
+	c := l.peek(0)
+
+	switch {
+	case strings.HasPrefix(l.src[l.offset:], "```"):
+		return l.multilineString(start)
+	case c == '\'' || c == '"':
+		return l.string(start, 0, false)
+	case c == '@' && (l.peek(1) == '\'' || l.peek(1) == '"'):
+		return l.string(start, 1, true)
+	case (c == 'h' || c == 'H') && (l.peek(1) == '\'' || l.peek(1) == '"'):
+		// Obfuscated string literals, which are hidden from query logs.
+		return l.string(start, 1, false)
+	case (c == 'h' || c == 'H') && l.peek(1) == '@' && (l.peek(2) == '\'' || l.peek(2) == '"'):
+		return l.string(start, 2, true)
+	case isIdentStart(c):
+		return l.token(Ident, start, l.scan(false)), nil
+	case isDigit(c):
+		// Timespans and reals such as 5m, 1.5h and 1e3 are single tokens.
+		return l.token(Number, start, l.scan(true)), nil
+	}
+
+	for _, p := range punctuation {
+		if strings.HasPrefix(l.src[l.offset:], p) {
+			return l.token(Punct, start, len(p)), nil
+		}
+	}
+
+	r, _ := utf8.DecodeRuneInString(l.src[l.offset:])
+	return Token{}, &Error{Pos: start, Msg: fmt.Sprintf("unexpected character %q", r)}
+}
+
+// scan returns the length of the identifier or number at the current
+// offset. Numbers may contain a decimal point followed by a digit.
+func (l *lexer) scan(number bool) int {
+	i := l.offset
+	for i < len(l.src) {
+		c := l.src[i]
+		if !isIdentPart(c) && !(number && c == '.' && i+1 < len(l.src) && isDigit(l.src[i+1])) {
+			break
+		}
+		i++
+	}
+	return i - l.offset
+}
+
+func (l *lexer) token(kind TokenKind, start Pos, n int) Token {
+	l.advance(n)
+	return Token{Kind: kind, Text: l.src[start.Offset:l.offset], Pos: start, End: l.offset}
+}
+
+// string lexes a string literal whose quote follows a prefix of the given
+// length. Verbatim strings don't support escapes, a quote is escaped by
+// doubling it.
+func (l *lexer) string(start Pos, prefix int, verbatim bool) (Token, error) {
+	quote := l.peek(prefix)
+	i := l.offset + prefix + 1
+	for i < len(l.src) {
+		switch c := l.src[i]; {
+		case c == '\\' && !verbatim:
+			i += 2
+			continue
+		case c == '\n':
+			return Token{}, &Error{Pos: start, Msg: "unterminated string literal"}
+		case c == quote && verbatim && i+1 < len(l.src) && l.src[i+1] == quote:
+			i += 2
+			continue
+		case c == quote:
+			return l.token(String, start, i+1-l.offset), nil
+		}
+		i++
+	}
+
+	return Token{}, &Error{Pos: start, Msg: "unterminated string literal"}
+}
+
+func (l *lexer) multilineString(start Pos) (Token, error) {
+	end := strings.Index(l.src[l.offset+3:], "```")
+	if end < 0 {
+		return Token{}, &Error{Pos: start, Msg: "unterminated multi-line string literal"}
+	}
+	return l.token(String, start, end+6), nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
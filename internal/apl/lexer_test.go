@@ -0,0 +1,114 @@
+package apl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLex(t *testing.T) {
+	t.Parallel()
+
+	tokens, err := Lex("['logs']\n| where status >= 500 and msg !contains \"it's\" // comment\n| summarize count() by bin(_time, 1.5m)")
+	require.NoError(t, err)
+
+	var texts []string
+	for _, token := range tokens {
+		texts = append(texts, token.Text)
+	}
+	assert.Equal(t, []string{
+		"[", "'logs'", "]",
+		"|", "where", "status", ">=", "500", "and", "msg", "!", "contains", `"it's"`,
+		"|", "summarize", "count", "(", ")", "by", "bin", "(", "_time", ",", "1.5m", ")",
+	}, texts)
+
+	assert.Equal(t, Pos{Offset: 9, Line: 2, Column: 1}, tokens[3].Pos)
+	assert.Equal(t, String, tokens[12].Kind)
+	assert.Equal(t, Number, tokens[23].Kind)
+}
+
+func TestLexStrings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "single quoted", src: `'a \' b'`},
+		{name: "double quoted", src: `"a \" b"`},
+		{name: "verbatim", src: `@'C:\logs'`},
+		{name: "verbatim with doubled quote", src: `@'it''s'`},
+		{name: "obfuscated", src: `h'secret'`},
+		{name: "obfuscated verbatim", src: `h@'secret'`},
+		{name: "multi-line", src: "```\nfirst\nsecond\n```"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := Lex(tt.src)
+			require.NoError(t, err)
+			require.Len(t, tokens, 1)
+			assert.Equal(t, String, tokens[0].Kind)
+			assert.Equal(t, tt.src, tokens[0].Text)
+		})
+	}
+}
+
+func TestLexErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     string
+		wantPos Pos
+		wantMsg string
+	}{
+		{
+			name:    "unterminated string",
+			src:     "['logs'] | where msg == 'oops",
+			wantPos: Pos{Offset: 24, Line: 1, Column: 25},
+			wantMsg: "unterminated string literal",
+		},
+		{
+			name:    "string across lines",
+			src:     "['logs']\n| where msg == \"oops\n\"",
+			wantPos: Pos{Offset: 24, Line: 2, Column: 16},
+			wantMsg: "unterminated string literal",
+		},
+		{
+			name:    "unterminated multi-line string",
+			src:     "print ```oops",
+			wantPos: Pos{Offset: 6, Line: 1, Column: 7},
+			wantMsg: "unterminated multi-line string literal",
+		},
+		{
+			name:    "unexpected character",
+			src:     "['logs'] | where a # b",
+			wantPos: Pos{Offset: 19, Line: 1, Column: 20},
+			wantMsg: `unexpected character '#'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Lex(tt.src)
+			var aplErr *Error
+			require.ErrorAs(t, err, &aplErr)
+			assert.Equal(t, tt.wantPos, aplErr.Pos)
+			assert.Equal(t, tt.wantMsg, aplErr.Msg)
+		})
+	}
+}
+
+func TestErrorExcerpt(t *testing.T) {
+	t.Parallel()
+
+	err := &Error{Pos: Pos{Line: 2, Column: 3}, Msg: "unknown tabular operator wher"}
+	assert.Equal(t, "line 2, column 3: unknown tabular operator wher", err.Error())
+	assert.Equal(t, "| wher a\n  ^", err.Excerpt("['logs']\n| wher a"))
+}
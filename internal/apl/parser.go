@@ -0,0 +1,348 @@
+package apl
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TabularOperators are the names of the tabular operators a query can pipe
+// its source through.
+var TabularOperators = []string{
+	"as",
+	"count",
+	"distinct",
+	"extend",
+	"extend-valid",
+	"externaldata",
+	"filter",
+	"getschema",
+	"join",
+	"limit",
+	"lookup",
+	"make-series",
+	"mv-expand",
+	"order",
+	"parse",
+	"parse-kv",
+	"parse-where",
+	"project",
+	"project-away",
+	"project-keep",
+	"project-rename",
+	"project-reorder",
+	"redact",
+	"sample",
+	"sample-distinct",
+	"search",
+	"sort",
+	"summarize",
+	"take",
+	"top",
+	"union",
+	"where",
+}
+
+// sourceFunctions are the functions that produce a table a query can start
+// with instead of a dataset reference.
+var sourceFunctions = []string{
+	"datatable",
+	"externaldata",
+	"find",
+	"print",
+	"range",
+	"search",
+	"union",
+}
+
+// closingBrackets maps the opening brackets to their closing brackets.
+var closingBrackets = map[string]string{
+	"(": ")",
+	"[": "]",
+	"{": "}",
+}
+
+// Query is the structure of a parsed query.
+type Query struct {
+	// Lets are the names defined by let statements, in order.
+	Lets []string
+	// Source is the tabular expression the query starts with.
+	Source Source
+	// Operators are the tabular operators applied to the source, in order.
+	Operators []Operator
+	// Warnings point at the parts of the query the parser doesn't know, such
+	// as operators missing from TabularOperators. They don't fail the parse,
+	// since the API may well accept them.
+	Warnings []*Error
+}
+
+// Source is the tabular expression a query starts with.
+type Source struct {
+	// Dataset is the name of the dataset if the source is a dataset
+	// reference such as ['logs'] or a bare dataset name such as logs.
+	Dataset string
+	Pos     Pos
+	Tokens  []Token
+}
+
+// Operator is a tabular operator of a query.
+type Operator struct {
+	Name string
+	Pos  Pos
+	Args []Token
+}
+
+// GroupsByTimeBin reports whether the operator is a summarize that groups by
+// a bin of _time, such as summarize count() by bin_auto(_time).
+func (op Operator) GroupsByTimeBin() bool {
+	if op.Name != "summarize" {
+		return false
+	}
+
+	by := -1
+	depth := 0
+	for i, token := range op.Args {
+		switch {
+		case token.Kind == Punct && closingBrackets[token.Text] != "":
+			depth++
+		case token.Is(")") || token.Is("]") || token.Is("}"):
+			depth--
+		case depth == 0 && token.Is("by"):
+			by = i
+		}
+	}
+	if by < 0 {
+		return false
+	}
+
+	args := op.Args[by+1:]
+	for i := 0; i+3 < len(args); i++ {
+		if !args[i+1].Is("(") || !args[i+2].Is("_time") {
+			continue
+		}
+		if args[i].Is("bin_auto") && args[i+3].Is(")") || args[i].Is("bin") && args[i+3].Is(",") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parse parses the source of a query. The error, if any, is an *Error.
+func Parse(src string) (*Query, error) {
+	tokens, err := Lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBrackets(tokens); err != nil {
+		return nil, err
+	}
+
+	statements := splitTopLevel(tokens, ";")
+
+	var query Query
+	var queryTokens []Token
+	for i, statement := range statements {
+		if len(statement.tokens) == 0 {
+			continue
+		}
+
+		first := statement.tokens[0]
+		switch {
+		case first.Is("let"):
+			name, err := parseLet(statement.tokens)
+			if err != nil {
+				return nil, err
+			}
+			query.Lets = append(query.Lets, name)
+		case first.Is("set"):
+		case queryTokens != nil:
+			return nil, &Error{Pos: statements[i].sep.Pos, Msg: "unexpected ; after the query, only let and set statements can precede it"}
+		default:
+			queryTokens = statement.tokens
+		}
+	}
+
+	if queryTokens == nil {
+		pos := Pos{Line: 1, Column: 1}
+		if len(tokens) > 0 {
+			pos = tokens[len(tokens)-1].Pos
+		}
+		return nil, &Error{Pos: pos, Msg: "expected a query"}
+	}
+
+	segments := splitTopLevel(queryTokens, "|")
+	if len(segments[0].tokens) == 0 {
+		return nil, &Error{Pos: segments[1].sep.Pos, Msg: "expected a dataset before |"}
+	}
+
+	if query.Source, err = parseSource(segments[0].tokens, query.Lets, &query.Warnings); err != nil {
+		return nil, err
+	}
+
+	for _, segment := range segments[1:] {
+		op, err := parseOperator(segment, &query.Warnings)
+		if err != nil {
+			return nil, err
+		}
+		query.Operators = append(query.Operators, op)
+	}
+
+	return &query, nil
+}
+
+// checkBrackets checks that all brackets are balanced.
+func checkBrackets(tokens []Token) error {
+	var open []Token
+	for _, token := range tokens {
+		if token.Kind != Punct {
+			continue
+		}
+
+		if _, ok := closingBrackets[token.Text]; ok {
+			open = append(open, token)
+			continue
+		}
+
+		if token.Text != ")" && token.Text != "]" && token.Text != "}" {
+			continue
+		}
+
+		if len(open) == 0 {
+			return &Error{Pos: token.Pos, Msg: fmt.Sprintf("unexpected %s without matching opening bracket", token.Text)}
+		}
+
+		last := open[len(open)-1]
+		if want := closingBrackets[last.Text]; token.Text != want {
+			return &Error{
+				Pos: token.Pos,
+				Msg: fmt.Sprintf("expected %s to close %s at line %d, column %d, got %s", want, last.Text, last.Pos.Line, last.Pos.Column, token.Text),
+			}
+		}
+		open = open[:len(open)-1]
+	}
+
+	if len(open) > 0 {
+		last := open[len(open)-1]
+		return &Error{Pos: last.Pos, Msg: fmt.Sprintf("unclosed %s", last.Text)}
+	}
+
+	return nil
+}
+
+// segment is a run of tokens and the separator token preceding it, if any.
+type segment struct {
+	sep    Token
+	tokens []Token
+}
+
+// splitTopLevel splits the tokens at the separators that are not enclosed in
+// brackets.
+func splitTopLevel(tokens []Token, sep string) []segment {
+	segments := []segment{{}}
+	depth := 0
+	for _, token := range tokens {
+		switch {
+		case token.Kind == Punct && closingBrackets[token.Text] != "":
+			depth++
+		case token.Is(")") || token.Is("]") || token.Is("}"):
+			depth--
+		case depth == 0 && token.Kind == Punct && token.Text == sep:
+			segments = append(segments, segment{sep: token})
+			continue
+		}
+
+		segments[len(segments)-1].tokens = append(segments[len(segments)-1].tokens, token)
+	}
+
+	return segments
+}
+
+// parseLet parses a let statement and returns the name it defines.
+func parseLet(tokens []Token) (string, error) {
+	if len(tokens) < 2 || tokens[1].Kind != Ident {
+		return "", &Error{Pos: tokens[0].Pos, Msg: "expected a name after let"}
+	}
+	if len(tokens) < 3 || !tokens[2].Is("=") {
+		return "", &Error{Pos: tokens[1].Pos, Msg: fmt.Sprintf("expected = after let %s", tokens[1].Text)}
+	}
+	if len(tokens) < 4 {
+		return "", &Error{Pos: tokens[2].Pos, Msg: fmt.Sprintf("expected a value for let %s", tokens[1].Text)}
+	}
+
+	return tokens[1].Text, nil
+}
+
+// parseSource parses the tabular expression a query starts with: a dataset
+// reference, a bare dataset name, a name defined by let, a table function or a
+// parenthesized query. Other identifiers are reported as warnings.
+func parseSource(tokens []Token, lets []string, warnings *[]*Error) (Source, error) {
+	first := tokens[0]
+	source := Source{Pos: first.Pos, Tokens: tokens}
+
+	switch {
+	case first.Is("["):
+		if len(tokens) < 3 || tokens[1].Kind != String || !tokens[2].Is("]") {
+			return source, &Error{Pos: first.Pos, Msg: "expected a quoted dataset name such as ['logs']"}
+		}
+		if len(tokens) > 3 {
+			return source, &Error{Pos: tokens[3].Pos, Msg: fmt.Sprintf("unexpected %s after the dataset reference, expected |", tokens[3].Text)}
+		}
+		source.Dataset = unquote(tokens[1].Text)
+	case first.Is("("):
+	case first.Kind == Ident && (slices.Contains(lets, first.Text) || slices.Contains(sourceFunctions, first.Text)):
+	case first.Kind == Ident && len(tokens) == 1:
+		source.Dataset = first.Text
+	case first.Kind == Ident:
+		*warnings = append(*warnings, &Error{Pos: first.Pos, Msg: fmt.Sprintf("unknown table %s, it is not checked", first.Text)})
+	default:
+		return source, &Error{Pos: first.Pos, Msg: fmt.Sprintf("unexpected %s, expected a dataset such as ['logs']", first.Text)}
+	}
+
+	return source, nil
+}
+
+// parseOperator parses the tabular operator following a pipe. Operators
+// missing from TabularOperators are reported as warnings.
+func parseOperator(segment segment, warnings *[]*Error) (Operator, error) {
+	if len(segment.tokens) == 0 {
+		return Operator{}, &Error{Pos: segment.sep.Pos, Msg: "expected a tabular operator after |"}
+	}
+
+	first := segment.tokens[0]
+	if first.Kind != Ident {
+		return Operator{}, &Error{Pos: first.Pos, Msg: fmt.Sprintf("unexpected %s, expected a tabular operator", first.Text)}
+	}
+
+	// Operators such as project-away are lexed as identifiers joined by
+	// minus signs without any space in between.
+	name := first.Text
+	n := 1
+	for n+1 < len(segment.tokens) {
+		minus, next := segment.tokens[n], segment.tokens[n+1]
+		if !minus.Is("-") || next.Kind != Ident || minus.Pos.Offset != segment.tokens[n-1].End || next.Pos.Offset != minus.End {
+			break
+		}
+		name += "-" + next.Text
+		n += 2
+	}
+
+	if !slices.Contains(TabularOperators, name) {
+		*warnings = append(*warnings, &Error{Pos: first.Pos, Msg: fmt.Sprintf("unknown tabular operator %s, its arguments are not checked", name)})
+	}
+
+	return Operator{Name: name, Pos: first.Pos, Args: segment.tokens[n:]}, nil
+}
+
+// unquote returns the value of a string literal.
+func unquote(literal string) string {
+	literal = strings.TrimLeft(literal, "hH@")
+	if value, err := strconv.Unquote(literal); err == nil {
+		return value
+	}
+
+	// Single quoted strings aren't valid Go strings.
+	return strings.ReplaceAll(literal[1:len(literal)-1], `\'`, `'`)
+}
@@ -0,0 +1,245 @@
+package apl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	query, err := Parse(`
+		let threshold = 500;
+		['http-logs']
+		| where status >= threshold
+		| project-away headers
+		| summarize count() by bin_auto(_time), ['geo.city']
+		| order by _time desc`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"threshold"}, query.Lets)
+	assert.Equal(t, "http-logs", query.Source.Dataset)
+
+	var names []string
+	for _, op := range query.Operators {
+		names = append(names, op.Name)
+	}
+	assert.Equal(t, []string{"where", "project-away", "summarize", "order"}, names)
+	assert.Equal(t, Pos{Offset: 99, Line: 6, Column: 5}, query.Operators[2].Pos)
+}
+
+func TestParseValid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		`['logs']`,
+		`["logs"] | count`,
+		`['logs'] | where msg has "a|b" | take 10`,
+		`['logs'] | join kind=inner (['users'] | where active) on id`,
+		`['logs'] | where level in~ ("error", "fatal") and msg !startswith "debug"`,
+		`['logs'] | mv-expand tags | make-series count() on _time step 1m`,
+		`['logs'] | parse-kv msg as (a:string) with (pair_delimiter=",")`,
+		`set truncationmaxrecords = 10; ['logs'] | limit 1`,
+		`let errors = ['logs'] | where level == "error"; errors | count`,
+		`union ['a'], ['b'] | summarize count() by bin(_time, 5m)`,
+		`print x = 1`,
+		`['logs'] // trailing comment`,
+		`logs | count`,
+	}
+
+	for _, src := range tests {
+		t.Run(src, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := Parse(src)
+			require.NoError(t, err)
+			assert.Empty(t, query.Warnings)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     string
+		wantPos Pos
+		wantMsg string
+	}{
+		{
+			name:    "empty",
+			src:     "  ",
+			wantPos: Pos{Line: 1, Column: 1},
+			wantMsg: "expected a query",
+		},
+		{
+			name:    "only let",
+			src:     "let a = 1;",
+			wantPos: Pos{Offset: 9, Line: 1, Column: 10},
+			wantMsg: "expected a query",
+		},
+		{
+			name:    "unquoted bracketed dataset",
+			src:     "[logs] | count",
+			wantPos: Pos{Offset: 0, Line: 1, Column: 1},
+			wantMsg: "expected a quoted dataset name such as ['logs']",
+		},
+		{
+			name:    "missing pipe after dataset",
+			src:     "['logs'] where a",
+			wantPos: Pos{Offset: 9, Line: 1, Column: 10},
+			wantMsg: "unexpected where after the dataset reference, expected |",
+		},
+		{
+			name:    "leading pipe",
+			src:     "| where a",
+			wantPos: Pos{Offset: 0, Line: 1, Column: 1},
+			wantMsg: "expected a dataset before |",
+		},
+		{
+			name:    "double pipe",
+			src:     "['logs'] | | where a",
+			wantPos: Pos{Offset: 9, Line: 1, Column: 10},
+			wantMsg: "expected a tabular operator after |",
+		},
+		{
+			name:    "trailing pipe",
+			src:     "['logs']\n| where a\n|",
+			wantPos: Pos{Offset: 19, Line: 3, Column: 1},
+			wantMsg: "expected a tabular operator after |",
+		},
+		{
+			name:    "operator is not an identifier",
+			src:     "['logs'] | 'where'",
+			wantPos: Pos{Offset: 11, Line: 1, Column: 12},
+			wantMsg: "unexpected 'where', expected a tabular operator",
+		},
+		{
+			name:    "unclosed parenthesis",
+			src:     "['logs'] | summarize count( by a",
+			wantPos: Pos{Offset: 26, Line: 1, Column: 27},
+			wantMsg: "unclosed (",
+		},
+		{
+			name:    "unexpected closing parenthesis",
+			src:     "['logs'] | where a)",
+			wantPos: Pos{Offset: 18, Line: 1, Column: 19},
+			wantMsg: "unexpected ) without matching opening bracket",
+		},
+		{
+			name:    "mismatched brackets",
+			src:     "['logs'] | where f(a]",
+			wantPos: Pos{Offset: 20, Line: 1, Column: 21},
+			wantMsg: "expected ) to close ( at line 1, column 19, got ]",
+		},
+		{
+			name:    "invalid let",
+			src:     "let = 1; ['logs']",
+			wantPos: Pos{Offset: 0, Line: 1, Column: 1},
+			wantMsg: "expected a name after let",
+		},
+		{
+			name:    "statement after query",
+			src:     "['logs'] | count; ['other']",
+			wantPos: Pos{Offset: 16, Line: 1, Column: 17},
+			wantMsg: "unexpected ; after the query, only let and set statements can precede it",
+		},
+		{
+			name:    "lex error",
+			src:     "['logs] | count",
+			wantPos: Pos{Offset: 1, Line: 1, Column: 2},
+			wantMsg: "unterminated string literal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tt.src)
+			var aplErr *Error
+			require.ErrorAs(t, err, &aplErr)
+			assert.Equal(t, tt.wantPos, aplErr.Pos)
+			assert.Equal(t, tt.wantMsg, aplErr.Msg)
+		})
+	}
+}
+
+func TestParseWarnings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     string
+		wantPos Pos
+		wantMsg string
+	}{
+		{
+			name:    "unknown operator",
+			src:     "['logs']\n| wher a",
+			wantPos: Pos{Offset: 11, Line: 2, Column: 3},
+			wantMsg: "unknown tabular operator wher, its arguments are not checked",
+		},
+		{
+			name:    "unknown hyphenated operator",
+			src:     "['logs'] | top-hitters 5 of host",
+			wantPos: Pos{Offset: 11, Line: 1, Column: 12},
+			wantMsg: "unknown tabular operator top-hitters, its arguments are not checked",
+		},
+		{
+			name:    "spaced hyphenated operator",
+			src:     "['logs'] | mv - expand a",
+			wantPos: Pos{Offset: 11, Line: 1, Column: 12},
+			wantMsg: "unknown tabular operator mv, its arguments are not checked",
+		},
+		{
+			name:    "unknown table function",
+			src:     "materialize(['logs']) | count",
+			wantPos: Pos{Offset: 0, Line: 1, Column: 1},
+			wantMsg: "unknown table materialize, it is not checked",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := Parse(tt.src)
+			require.NoError(t, err)
+			require.Len(t, query.Warnings, 1)
+			assert.Equal(t, tt.wantPos, query.Warnings[0].Pos)
+			assert.Equal(t, tt.wantMsg, query.Warnings[0].Msg)
+		})
+	}
+}
+
+func TestOperatorGroupsByTimeBin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{src: "['logs'] | summarize count() by bin_auto(_time)", want: true},
+		{src: "['logs'] | summarize count() by host, bin_auto(_time)", want: true},
+		{src: "['logs'] | summarize count() by bin(_time, 5m)", want: true},
+		{src: "['logs'] | summarize count()", want: false},
+		{src: "['logs'] | summarize count() by host", want: false},
+		{src: "['logs'] | summarize countif(x == bin_auto(_time)) by host", want: false},
+		{src: "['logs'] | where bin_auto(_time) > 0", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := Parse(tt.src)
+			require.NoError(t, err)
+			require.Len(t, query.Operators, 1)
+			assert.Equal(t, tt.want, query.Operators[0].GroupsByTimeBin())
+		})
+	}
+}